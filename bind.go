@@ -0,0 +1,376 @@
+package getenv
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/obalunenko/getenv/internal"
+	"github.com/obalunenko/getenv/option"
+)
+
+// Struct tags recognized by Bind.
+const (
+	// tagEnv holds the environment variable name of the field.
+	tagEnv = "env"
+	// tagSeparator overrides the slice separator for the field.
+	tagSeparator = "sep"
+	// tagKeyValueSeparator overrides the map key/value separator for the field.
+	tagKeyValueSeparator = "kvsep"
+	// tagOuterSeparator overrides the two-level slice row separator for the field.
+	tagOuterSeparator = "outersep"
+	// tagLayout overrides the time layout for the field.
+	tagLayout = "layout"
+	// tagDefault holds the value used when the variable is not set.
+	tagDefault = "default"
+)
+
+// Options of the env tag recognized by Bind.
+const (
+	// tagOptInline flattens nested struct fields into the parent without adding a prefix segment.
+	tagOptInline = "inline"
+	// tagOptNoPrefix drops the prefix accumulated from the parent structs.
+	tagOptNoPrefix = "noprefix"
+	// tagOptRequired makes Bind fail when the variable is not set.
+	tagOptRequired = "required"
+	// tagOptSensitive keeps the value out of the errors, see option.WithSensitive.
+	tagOptSensitive = "sensitive"
+	// tagOptOptional leaves the field unchanged when the variable is not set, even with option.WithRequireAll.
+	tagOptOptional = "optional"
+)
+
+// defaultPrefixDelimiter joins key prefix segments when no delimiter is set with option.WithPrefixDelimiter.
+const defaultPrefixDelimiter = "_"
+
+// errInvalidBindTarget is returned when Bind is called with anything but a non-nil pointer to a struct.
+var errInvalidBindTarget = errors.New("bind target must be a non-nil pointer to a struct")
+
+// Bind populates the struct pointed to by v with the values of environment variables.
+//
+// Each exported field tagged with `env:"KEY"` is parsed from the variable KEY using the same parser
+// as EnvCustom would use for the field type, so every type supported by Env, the types registered
+// with RegisterParser and encoding.TextUnmarshaler implementations are supported by Bind.
+// Fields without the tag are skipped.
+//
+// Options are applied to every field. The per-field tags `sep:","`, `kvsep:"="`, `outersep:";"`
+// and `layout:"2006-01-02"` override the separator, the map key/value separator, the two-level slice
+// row separator and the time layout for a single field.
+//
+// Nested struct fields contribute a key prefix: the name from the env tag or, when the tag is omitted,
+// the field name in upper snake case. Prefix segments are joined with "_" or the delimiter
+// set by option.WithPrefixDelimiter, and option.WithPrefix sets the prefix of the top level struct.
+// Embedded structs and fields tagged with `env:",inline"` are flattened into the parent.
+// Nil pointers to structs, embedded or not, are allocated.
+// The `noprefix` tag option drops the prefix accumulated from the parent structs,
+// e.g. `env:"HOME,noprefix"` always reads HOME.
+//
+// The `default:"..."` tag holds the value used when the variable is not set, it is parsed
+// with the same parser and options as the variable itself.
+// Fields tagged with `env:"KEY,required"` make Bind return an error matching ErrNotSet
+// when the variable is not set and there is no default. Otherwise, unset variables leave the field
+// value unchanged. option.WithRequireAll makes every field required unless it is tagged with
+// `env:"KEY,optional"`. Fields tagged with `env:"KEY,sensitive"` keep the value out of the errors
+// like option.WithSensitive does.
+//
+// The first error is returned.
+//
+// Example:
+//
+//	type DB struct {
+//		Host string `env:"HOST"`
+//		Port int    `env:"PORT"`
+//	}
+//
+//	type Config struct {
+//		DB      DB            // APP_DB_HOST, APP_DB_PORT
+//		Token   string        `env:"TOKEN,required"`
+//		Peers   []string      `env:"PEERS" sep:","`
+//		Timeout time.Duration `env:"TIMEOUT" default:"30s"`
+//	}
+//
+//	var cfg Config
+//
+//	err := getenv.Bind(&cfg, option.WithPrefix("APP"))
+func Bind(v any, options ...option.Option) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("failed to bind %T: %w", v, errInvalidBindTarget)
+	}
+
+	params := newParseParams(options)
+
+	if params.PrefixDelimiter == "" {
+		params.PrefixDelimiter = defaultPrefixDelimiter
+	}
+
+	return bindStruct(rv.Elem(), params.Prefix, params, nil)
+}
+
+// bindStruct populates tagged fields of the struct value, prefixing their keys with prefix.
+// The parents hold the types of the structs that contain the value.
+func bindStruct(rv reflect.Value, prefix string, params internal.Parameters, parents []reflect.Type) error {
+	rt := rv.Type()
+	parents = append(parents, rt)
+
+	for i := range rt.NumField() {
+		field := rt.Field(i)
+
+		// Exported fields of embedded unexported structs are still settable.
+		if !field.IsExported() && !(field.Anonymous && isStruct(field.Type)) {
+			continue
+		}
+
+		tag := parseEnvTag(field.Tag.Get(tagEnv))
+
+		p, ok := internal.LookupEnvParser(reflect.Zero(field.Type).Interface())
+		if !ok && isStruct(field.Type) {
+			sv, err := structValue(rv.Field(i), field, parents)
+			if err != nil {
+				return fmt.Errorf("failed to bind field %s: %w", field.Name, err)
+			}
+
+			if err = bindStruct(sv, nestedPrefix(field, tag, prefix, params), params, parents); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if tag.name == "" || !field.IsExported() {
+			continue
+		}
+
+		if !ok {
+			return fmt.Errorf("failed to bind field %s: %w %s", field.Name, errUnsupportedType, field.Type)
+		}
+
+		key := tag.name
+		if !tag.noPrefix {
+			key = joinKey(prefix, key, params.PrefixDelimiter)
+		}
+
+		if err := bindField(rv.Field(i), field, tag, p, key, params); err != nil {
+			return fmt.Errorf("failed to bind field %s: %w", field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// bindField parses the environment variable named by key into the field value.
+func bindField(
+	fv reflect.Value,
+	field reflect.StructField,
+	tag envTag,
+	p internal.EnvParser,
+	key string,
+	params internal.Parameters,
+) error {
+	params = fieldParams(field, params)
+
+	if tag.sensitive {
+		params.Sensitive = true
+	}
+
+	val, err := p.ParseEnv(key, params)
+	if err == nil && isNilPointer(val) {
+		// Pointer parsers report not set variables as nil.
+		err = internal.ErrNotSet
+	}
+
+	if errors.Is(err, internal.ErrNotSet) {
+		def := field.Tag.Get(tagDefault)
+
+		switch {
+		case def != "":
+			params.Source = newDefaultSource(key, def, params.Source)
+
+			val, err = p.ParseEnv(key, params)
+			if err != nil {
+				return newEnvError(key, fmt.Errorf("default value: %w", newParseError(key, field.Type, params, err)))
+			}
+		case tag.required || (params.RequireAll && !tag.optional):
+			return newEnvError(key, err)
+		default:
+			return nil
+		}
+	}
+
+	if err != nil {
+		return newEnvError(key, newParseError(key, field.Type, params, err))
+	}
+
+	fv.Set(reflect.ValueOf(val))
+
+	return nil
+}
+
+// fieldParams overrides parameters with the values from the field tags.
+func fieldParams(field reflect.StructField, params internal.Parameters) internal.Parameters {
+	if sep, ok := field.Tag.Lookup(tagSeparator); ok {
+		params.Separator = sep
+	}
+
+	if sep, ok := field.Tag.Lookup(tagKeyValueSeparator); ok {
+		params.KeyValueSeparator = sep
+	}
+
+	if sep, ok := field.Tag.Lookup(tagOuterSeparator); ok {
+		params.OuterSeparator = sep
+	}
+
+	if layout, ok := field.Tag.Lookup(tagLayout); ok {
+		params.Layout = layout
+	}
+
+	return params
+}
+
+// envTag is a parsed env struct tag.
+type envTag struct {
+	name      string
+	inline    bool
+	noPrefix  bool
+	required  bool
+	optional  bool
+	sensitive bool
+}
+
+// parseEnvTag parses env struct tag in the form of "NAME,opt1,opt2".
+func parseEnvTag(tag string) envTag {
+	name, opts, _ := strings.Cut(tag, ",")
+
+	t := envTag{
+		name: name,
+	}
+
+	for opt := range strings.SplitSeq(opts, ",") {
+		switch opt {
+		case tagOptInline:
+			t.inline = true
+		case tagOptNoPrefix:
+			t.noPrefix = true
+		case tagOptRequired:
+			t.required = true
+		case tagOptOptional:
+			t.optional = true
+		case tagOptSensitive:
+			t.sensitive = true
+		}
+	}
+
+	return t
+}
+
+// isStruct reports whether the type is a struct or a pointer to a struct.
+func isStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t.Kind() == reflect.Struct
+}
+
+// structValue returns the struct held by the field, a nil pointer to a struct is allocated.
+// Pointers to the parent structs and embedded pointers to unexported structs are not supported.
+func structValue(fv reflect.Value, field reflect.StructField, parents []reflect.Type) (reflect.Value, error) {
+	if field.Type.Kind() == reflect.Struct {
+		return fv, nil
+	}
+
+	if slices.Contains(parents, field.Type.Elem()) {
+		return reflect.Value{}, fmt.Errorf("%w %s: recursive struct", errUnsupportedType, field.Type)
+	}
+
+	if !field.IsExported() {
+		return reflect.Value{}, fmt.Errorf("%w %s: embedded pointer to unexported struct", errUnsupportedType, field.Type)
+	}
+
+	if fv.IsNil() {
+		fv.Set(reflect.New(field.Type.Elem()))
+	}
+
+	return fv.Elem(), nil
+}
+
+// nestedPrefix returns the key prefix for the fields of the nested struct field.
+func nestedPrefix(field reflect.StructField, tag envTag, prefix string, params internal.Parameters) string {
+	if tag.noPrefix {
+		prefix = ""
+	}
+
+	if tag.inline || (field.Anonymous && tag.name == "") {
+		return prefix
+	}
+
+	segment := tag.name
+	if segment == "" {
+		segment = toUpperSnake(field.Name)
+	}
+
+	return joinKey(prefix, segment, params.PrefixDelimiter)
+}
+
+// joinKey joins prefix and key with the delimiter.
+func joinKey(prefix, key, delimiter string) string {
+	if prefix == "" {
+		return key
+	}
+
+	return prefix + delimiter + key
+}
+
+// toUpperSnake converts Go identifier to upper snake case, e.g. HTTPServer to HTTP_SERVER.
+func toUpperSnake(s string) string {
+	runes := []rune(s)
+
+	var b strings.Builder
+
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteRune('_')
+			}
+		}
+
+		b.WriteRune(unicode.ToUpper(r))
+	}
+
+	return b.String()
+}
+
+// defaultSource is a source that holds the default value of a single field.
+// Other keys, e.g. the ones referenced by the expansion, are looked up in the fallback source.
+type defaultSource struct {
+	key      string
+	value    string
+	fallback internal.Source
+}
+
+// newDefaultSource returns a source with the default value of the key on top of the fallback source,
+// the process environment is used when it is nil.
+func newDefaultSource(key, value string, fallback internal.Source) defaultSource {
+	if fallback == nil {
+		fallback = OSEnv{}
+	}
+
+	return defaultSource{
+		key:      key,
+		value:    value,
+		fallback: fallback,
+	}
+}
+
+func (d defaultSource) Lookup(key string) (string, bool) {
+	if key != d.key {
+		return d.fallback.Lookup(key)
+	}
+
+	return d.value, true
+}
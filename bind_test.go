@@ -0,0 +1,483 @@
+package getenv_test
+
+import (
+	"net"
+	"net/netip"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/getenv"
+	"github.com/obalunenko/getenv/option"
+)
+
+type bindConfig struct {
+	String       string           `env:"GH_GETENV_BIND_STRING"`
+	StringSlice  []string         `env:"GH_GETENV_BIND_STRING_SLICE" sep:";"`
+	Int          int              `env:"GH_GETENV_BIND_INT"`
+	Int8Slice    []int8           `env:"GH_GETENV_BIND_INT8_SLICE"`
+	Uint64       uint64           `env:"GH_GETENV_BIND_UINT64"`
+	Float64      float64          `env:"GH_GETENV_BIND_FLOAT64"`
+	Bool         bool             `env:"GH_GETENV_BIND_BOOL"`
+	Time         time.Time        `env:"GH_GETENV_BIND_TIME" layout:"2006-01-02"`
+	Duration     time.Duration    `env:"GH_GETENV_BIND_DURATION"`
+	URL          url.URL          `env:"GH_GETENV_BIND_URL"`
+	IP           net.IP           `env:"GH_GETENV_BIND_IP"`
+	Addr         netip.Addr       `env:"GH_GETENV_BIND_ADDR"`
+	Prefix       netip.Prefix     `env:"GH_GETENV_BIND_PREFIX"`
+	HardwareAddr net.HardwareAddr `env:"GH_GETENV_BIND_MAC"`
+	Complex      complex128       `env:"GH_GETENV_BIND_COMPLEX"`
+	Untagged     string
+	unexported   string `env:"GH_GETENV_BIND_UNEXPORTED"`
+}
+
+func TestBind(t *testing.T) {
+	env := map[string]string{
+		"GH_GETENV_BIND_STRING":       "golly",
+		"GH_GETENV_BIND_STRING_SLICE": "a;b",
+		"GH_GETENV_BIND_INT":          "42",
+		"GH_GETENV_BIND_INT8_SLICE":   "1,-2",
+		"GH_GETENV_BIND_UINT64":       "64",
+		"GH_GETENV_BIND_FLOAT64":      "1.5",
+		"GH_GETENV_BIND_BOOL":         "true",
+		"GH_GETENV_BIND_TIME":         "2022-01-20",
+		"GH_GETENV_BIND_DURATION":     "2h35m",
+		"GH_GETENV_BIND_URL":          "https://example.com/path",
+		"GH_GETENV_BIND_IP":           "2001:cb8::17",
+		"GH_GETENV_BIND_ADDR":         "127.0.0.1",
+		"GH_GETENV_BIND_PREFIX":       "192.168.0.0/24",
+		"GH_GETENV_BIND_MAC":          "01:23:45:67:89:ab",
+		"GH_GETENV_BIND_COMPLEX":      "1+2i",
+		"GH_GETENV_BIND_UNEXPORTED":   "hidden",
+	}
+
+	for k, v := range env {
+		t.Setenv(k, v)
+	}
+
+	cfg := bindConfig{
+		Untagged: "keep",
+	}
+
+	err := getenv.Bind(&cfg, option.WithSeparator(","))
+	require.NoError(t, err)
+
+	mac, err := net.ParseMAC("01:23:45:67:89:ab")
+	require.NoError(t, err)
+
+	expected := bindConfig{
+		String:       "golly",
+		StringSlice:  []string{"a", "b"},
+		Int:          42,
+		Int8Slice:    []int8{1, -2},
+		Uint64:       64,
+		Float64:      1.5,
+		Bool:         true,
+		Time:         time.Date(2022, 1, 20, 0, 0, 0, 0, time.UTC),
+		Duration:     2*time.Hour + 35*time.Minute,
+		URL:          getURL(t, "https://example.com/path"),
+		IP:           getIP(t, "2001:cb8::17"),
+		Addr:         netip.MustParseAddr("127.0.0.1"),
+		Prefix:       netip.MustParsePrefix("192.168.0.0/24"),
+		HardwareAddr: mac,
+		Complex:      1 + 2i,
+		Untagged:     "keep",
+	}
+
+	assert.Equal(t, expected, cfg)
+}
+
+func TestBindNotSetKeepsValue(t *testing.T) {
+	type config struct {
+		Port int `env:"GH_GETENV_BIND_NOT_SET"`
+	}
+
+	cfg := config{
+		Port: 8080,
+	}
+
+	require.NoError(t, getenv.Bind(&cfg))
+	assert.Equal(t, 8080, cfg.Port)
+}
+
+func TestBindErrors(t *testing.T) {
+	type invalid struct {
+		Port int `env:"GH_GETENV_BIND_PORT"`
+	}
+
+	type unsupported struct {
+		Value chan int `env:"GH_GETENV_BIND_PORT"`
+	}
+
+	t.Setenv("GH_GETENV_BIND_PORT", "80s")
+
+	tests := []struct {
+		name    string
+		target  any
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "invalid value",
+			target:  &invalid{},
+			wantErr: errorEqual(getenv.ErrInvalidValue),
+		},
+		{
+			name:   "unsupported type",
+			target: &unsupported{},
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorContains(t, err, "unsupported type chan int", i...)
+			},
+		},
+		{
+			name:    "not a pointer",
+			target:  invalid{},
+			wantErr: assert.Error,
+		},
+		{
+			name:    "nil pointer",
+			target:  (*invalid)(nil),
+			wantErr: assert.Error,
+		},
+		{
+			name:    "pointer to not a struct",
+			target:  new(int),
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.wantErr(t, getenv.Bind(tt.target))
+		})
+	}
+}
+
+type bindDB struct {
+	Host string `env:"HOST"`
+	Port int    `env:"PORT"`
+}
+
+type bindLog struct {
+	Level string `env:"LOG_LEVEL"`
+}
+
+type bindCommon struct {
+	Name string `env:"NAME"`
+}
+
+type bindNested struct {
+	bindCommon
+
+	DB         bindDB
+	Replica    bindDB  `env:"RO"`
+	HTTPServer bindLog `env:",inline"`
+	Home       string  `env:"GH_GETENV_BIND_HOME,noprefix"`
+	Global     bindDB  `env:"GH_GETENV_BIND_GLOBAL,noprefix"`
+}
+
+func TestBindNested(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		options  []option.Option
+		expected bindNested
+	}{
+		{
+			name: "default delimiter",
+			env: map[string]string{
+				"APP_NAME":                   "svc",
+				"APP_DB_HOST":                "db.local",
+				"APP_DB_PORT":                "5432",
+				"APP_RO_HOST":                "ro.local",
+				"APP_LOG_LEVEL":              "debug",
+				"GH_GETENV_BIND_HOME":        "/home",
+				"GH_GETENV_BIND_GLOBAL_HOST": "global.local",
+			},
+			options: []option.Option{
+				option.WithPrefix("APP"),
+			},
+			expected: bindNested{
+				bindCommon: bindCommon{
+					Name: "svc",
+				},
+				DB: bindDB{
+					Host: "db.local",
+					Port: 5432,
+				},
+				Replica: bindDB{
+					Host: "ro.local",
+				},
+				HTTPServer: bindLog{
+					Level: "debug",
+				},
+				Home: "/home",
+				Global: bindDB{
+					Host: "global.local",
+				},
+			},
+		},
+		{
+			name: "custom delimiter without prefix",
+			env: map[string]string{
+				"DB__HOST": "db.local",
+				"RO__PORT": "5433",
+			},
+			options: []option.Option{
+				option.WithPrefixDelimiter("__"),
+			},
+			expected: bindNested{
+				DB: bindDB{
+					Host: "db.local",
+				},
+				Replica: bindDB{
+					Port: 5433,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			var cfg bindNested
+
+			require.NoError(t, getenv.Bind(&cfg, tt.options...))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestBindNestedAutoPrefix(t *testing.T) {
+	type config struct {
+		HTTPServer  bindDB
+		CacheTTL    bindDB
+		Http2Config bindDB
+	}
+
+	t.Setenv("HTTP_SERVER_HOST", "http")
+	t.Setenv("CACHE_TTL_HOST", "cache")
+	t.Setenv("HTTP2_CONFIG_HOST", "http2")
+
+	var cfg config
+
+	require.NoError(t, getenv.Bind(&cfg))
+	assert.Equal(t, "http", cfg.HTTPServer.Host)
+	assert.Equal(t, "cache", cfg.CacheTTL.Host)
+	assert.Equal(t, "http2", cfg.Http2Config.Host)
+}
+
+func TestBindNestedPointer(t *testing.T) {
+	type Base struct {
+		Name string `env:"NAME"`
+	}
+
+	type DB struct {
+		Host string `env:"HOST"`
+	}
+
+	type config struct {
+		*Base
+		DB    *DB
+		Cache *DB
+	}
+
+	src := getenv.MapSource{
+		"NAME":       "svc",
+		"DB_HOST":    "db.local",
+		"CACHE_HOST": "cache.local",
+	}
+
+	cache := &DB{Host: "old"}
+	cfg := config{Cache: cache}
+
+	require.NoError(t, getenv.Bind(&cfg, option.WithSource(src)))
+	require.NotNil(t, cfg.Base)
+	assert.Equal(t, "svc", cfg.Name)
+	require.NotNil(t, cfg.DB)
+	assert.Equal(t, "db.local", cfg.DB.Host)
+	assert.Same(t, cache, cfg.Cache)
+	assert.Equal(t, "cache.local", cfg.Cache.Host)
+}
+
+func TestBindNestedPointerErrors(t *testing.T) {
+	type base struct {
+		Name string `env:"NAME"`
+	}
+
+	type unexported struct {
+		*base
+	}
+
+	type node struct {
+		Name string `env:"NAME"`
+		Next *node
+	}
+
+	err := getenv.Bind(&unexported{})
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "unsupported type")
+
+	err = getenv.Bind(&node{})
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "recursive struct")
+}
+
+func TestBindDefaultRequiredOptional(t *testing.T) {
+	type config struct {
+		Timeout  time.Duration `env:"GH_GETENV_BIND_TIMEOUT" default:"30s"`
+		Peers    []int         `env:"GH_GETENV_BIND_PEERS" default:"1;2" sep:";"`
+		Token    string        `env:"GH_GETENV_BIND_TOKEN,required"`
+		Optional string        `env:"GH_GETENV_BIND_OPTIONAL,optional"`
+		Plain    string        `env:"GH_GETENV_BIND_PLAIN"`
+	}
+
+	type expected struct {
+		cfg     config
+		wantErr assert.ErrorAssertionFunc
+	}
+
+	tests := []struct {
+		name     string
+		env      map[string]string
+		options  []option.Option
+		expected expected
+	}{
+		{
+			name: "defaults applied",
+			env: map[string]string{
+				"GH_GETENV_BIND_TOKEN": "secret",
+			},
+			expected: expected{
+				cfg: config{
+					Timeout: 30 * time.Second,
+					Peers:   []int{1, 2},
+					Token:   "secret",
+				},
+				wantErr: assert.NoError,
+			},
+		},
+		{
+			name: "env overrides defaults",
+			env: map[string]string{
+				"GH_GETENV_BIND_TIMEOUT": "1m",
+				"GH_GETENV_BIND_PEERS":   "3",
+				"GH_GETENV_BIND_TOKEN":   "secret",
+			},
+			expected: expected{
+				cfg: config{
+					Timeout: time.Minute,
+					Peers:   []int{3},
+					Token:   "secret",
+				},
+				wantErr: assert.NoError,
+			},
+		},
+		{
+			name: "required not set",
+			env:  map[string]string{},
+			expected: expected{
+				cfg: config{
+					Timeout: 30 * time.Second,
+					Peers:   []int{1, 2},
+				},
+				wantErr: errorEqual(getenv.ErrNotSet),
+			},
+		},
+		{
+			name: "require all, plain not set",
+			env: map[string]string{
+				"GH_GETENV_BIND_TOKEN": "secret",
+			},
+			options: []option.Option{
+				option.WithRequireAll(),
+			},
+			expected: expected{
+				cfg: config{
+					Timeout: 30 * time.Second,
+					Peers:   []int{1, 2},
+					Token:   "secret",
+				},
+				wantErr: func(t assert.TestingT, err error, i ...any) bool {
+					return errorEqual(getenv.ErrNotSet)(t, err, i...) &&
+						assert.ErrorContains(t, err, "GH_GETENV_BIND_PLAIN", i...)
+				},
+			},
+		},
+		{
+			name: "require all, optional and defaults allowed",
+			env: map[string]string{
+				"GH_GETENV_BIND_TOKEN": "secret",
+				"GH_GETENV_BIND_PLAIN": "plain",
+			},
+			options: []option.Option{
+				option.WithRequireAll(),
+			},
+			expected: expected{
+				cfg: config{
+					Timeout: 30 * time.Second,
+					Peers:   []int{1, 2},
+					Token:   "secret",
+					Plain:   "plain",
+				},
+				wantErr: assert.NoError,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			var cfg config
+
+			err := getenv.Bind(&cfg, tt.options...)
+			if !tt.expected.wantErr(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.expected.cfg, cfg)
+		})
+	}
+}
+
+func TestBindInvalidDefault(t *testing.T) {
+	type config struct {
+		Port int `env:"GH_GETENV_BIND_PORT" default:"http"`
+	}
+
+	var cfg config
+
+	err := getenv.Bind(&cfg)
+	errorEqual(getenv.ErrInvalidValue)(t, err)
+	assert.ErrorContains(t, err, "default value")
+}
+
+func TestBindDefaultExpand(t *testing.T) {
+	type config struct {
+		URL  string `env:"URL" default:"http://${HOST}:${PORT:-8080}"`
+		Path string `env:"PATH" default:"/${PATH_PREFIX:?prefix is required}"`
+	}
+
+	src := getenv.MapSource{
+		"HOST": "h",
+	}
+
+	var cfg config
+
+	err := getenv.Bind(&cfg, option.WithSource(src), option.WithExpand())
+	require.ErrorIs(t, err, getenv.ErrInvalidValue)
+	assert.ErrorContains(t, err, "PATH_PREFIX: prefix is required")
+
+	src["PATH_PREFIX"] = "v1"
+
+	require.NoError(t, getenv.Bind(&cfg, option.WithSource(src), option.WithExpand()))
+	assert.Equal(t, config{URL: "http://h:8080", Path: "/v1"}, cfg)
+}
@@ -0,0 +1,33 @@
+package getenv
+
+import (
+	"github.com/obalunenko/getenv/internal"
+)
+
+// ByteSize is a size in bytes, e.g. a cache size or a body limit.
+//
+// Env parses it from a number with an optional case-insensitive SI or IEC unit, e.g. 1024, 64MiB, 1.5GB or 512 kib.
+// Fractional sizes are truncated to whole bytes, sizes that overflow uint64 are reported as ErrOutOfRange.
+// String formats it back with the largest unit that represents it exactly, e.g. 64MiB.
+type ByteSize = internal.ByteSize
+
+// Byte sizes in SI units.
+const (
+	B  = internal.B
+	KB = internal.KB
+	MB = internal.MB
+	GB = internal.GB
+	TB = internal.TB
+	PB = internal.PB
+	EB = internal.EB
+)
+
+// Byte sizes in IEC units.
+const (
+	KiB = internal.KiB
+	MiB = internal.MiB
+	GiB = internal.GiB
+	TiB = internal.TiB
+	PiB = internal.PiB
+	EiB = internal.EiB
+)
@@ -0,0 +1,48 @@
+package getenv_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/getenv"
+	"github.com/obalunenko/getenv/option"
+)
+
+func TestEnvByteSize(t *testing.T) {
+	src := getenv.MapSource{
+		"CACHE":    "512MiB",
+		"LIMITS":   "1.5GB,64KiB",
+		"INVALID":  "lots",
+		"OVERFLOW": "20EiB",
+	}
+
+	cache, err := getenv.Env[getenv.ByteSize]("CACHE", option.WithSource(src))
+	require.NoError(t, err)
+	assert.Equal(t, 512*getenv.MiB, cache)
+	assert.Equal(t, "512MiB", cache.String())
+
+	limits, err := getenv.Env[[]getenv.ByteSize]("LIMITS", option.WithSource(src), option.WithSeparator(","))
+	require.NoError(t, err)
+	assert.Equal(t, []getenv.ByteSize{1500 * getenv.MB, 64 * getenv.KiB}, limits)
+
+	_, err = getenv.Env[getenv.ByteSize]("INVALID", option.WithSource(src))
+	errorEqual(getenv.ErrInvalidValue)(t, err)
+	assert.ErrorIs(t, err, getenv.ErrSyntax)
+
+	_, err = getenv.Env[getenv.ByteSize]("OVERFLOW", option.WithSource(src))
+	errorEqual(getenv.ErrInvalidValue)(t, err)
+	assert.ErrorIs(t, err, getenv.ErrOutOfRange)
+
+	assert.Equal(t, getenv.GiB, getenv.EnvOrDefault("MISSING", getenv.GiB, option.WithSource(src)))
+
+	size, err := getenv.Env[*getenv.ByteSize]("CACHE", option.WithSource(src))
+	require.NoError(t, err)
+	require.NotNil(t, size)
+	assert.Equal(t, 512*getenv.MiB, *size)
+
+	size, err = getenv.Env[*getenv.ByteSize]("MISSING", option.WithSource(src))
+	require.NoError(t, err)
+	assert.Nil(t, size)
+}
@@ -0,0 +1,116 @@
+package getenv
+
+import (
+	"errors"
+
+	"github.com/obalunenko/getenv/internal"
+	"github.com/obalunenko/getenv/option"
+)
+
+// KeyError records the failed lookup of the environment variable named by Key.
+// Err matches ErrNotSet or ErrInvalidValue with errors.Is.
+type KeyError struct {
+	Key string
+	Err error
+}
+
+func (e *KeyError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *KeyError) Unwrap() error {
+	return e.Err
+}
+
+// Collector gathers the errors of many lookups, so that every missing and invalid variable
+// is reported at once instead of one at a time.
+//
+// Lookups are made with Collect and CollectCustom, Err returns the joined error.
+// Collector is not safe for concurrent use.
+//
+// Example:
+//
+//	c := getenv.NewCollector(option.WithSeparator(","))
+//
+//	cfg := Config{
+//		Host:  getenv.Collect[string](c, "HOST"),
+//		Port:  getenv.Collect[int](c, "PORT"),
+//		Peers: getenv.Collect[[]string](c, "PEERS"),
+//	}
+//
+//	if err := c.Err(); err != nil {
+//		return err // lists every failed variable
+//	}
+type Collector struct {
+	options []option.Option
+	errs    []*KeyError
+}
+
+// NewCollector creates Collector that applies options to every lookup.
+func NewCollector(options ...option.Option) *Collector {
+	return &Collector{
+		options: options,
+	}
+}
+
+// Collect retrieves the value of the environment variable named by the key like Env.
+// On failure, the error is recorded in the collector and the zero value is returned.
+// The options are applied after the collector options.
+func Collect[T internal.EnvParsable](c *Collector, key string, options ...option.Option) T {
+	val, err := Env[T](key, c.withOptions(options)...)
+	c.record(key, err)
+
+	return val
+}
+
+// CollectCustom retrieves the value of the environment variable named by the key like EnvCustom.
+// On failure, the error is recorded in the collector and the zero value is returned.
+// The options are applied after the collector options.
+func CollectCustom[T any](c *Collector, key string, options ...option.Option) T {
+	val, err := EnvCustom[T](key, c.withOptions(options)...)
+	c.record(key, err)
+
+	return val
+}
+
+// Err returns the errors of all failed lookups joined with errors.Join, or nil if there are none.
+// The joined error matches ErrNotSet and ErrInvalidValue with errors.Is
+// and *KeyError of the first failed lookup with errors.As, see Errors for all of them.
+func (c *Collector) Err() error {
+	if len(c.errs) == 0 {
+		return nil
+	}
+
+	errs := make([]error, 0, len(c.errs))
+
+	for _, err := range c.errs {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// Errors returns the errors of all failed lookups in the order of the lookups.
+func (c *Collector) Errors() []*KeyError {
+	return c.errs
+}
+
+// withOptions returns the collector options followed by the lookup options.
+func (c *Collector) withOptions(options []option.Option) []option.Option {
+	opts := make([]option.Option, 0, len(c.options)+len(options))
+	opts = append(opts, c.options...)
+
+	return append(opts, options...)
+}
+
+// record records the lookup error, if any.
+func (c *Collector) record(key string, err error) {
+	if err == nil {
+		return
+	}
+
+	c.errs = append(c.errs, &KeyError{
+		Key: key,
+		Err: err,
+	})
+}
@@ -0,0 +1,67 @@
+package getenv_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/getenv"
+	"github.com/obalunenko/getenv/option"
+)
+
+func TestCollector(t *testing.T) {
+	src := getenv.MapSource{
+		"HOST":    "localhost",
+		"PORT":    "80s",
+		"PEERS":   "a;b",
+		"TIMEOUT": "soon",
+	}
+
+	c := getenv.NewCollector(option.WithSource(src), option.WithSeparator(","))
+
+	host := getenv.Collect[string](c, "HOST")
+	port := getenv.Collect[int](c, "PORT")
+	peers := getenv.Collect[[]string](c, "PEERS", option.WithSeparator(";"))
+	token := getenv.Collect[string](c, "TOKEN")
+	timeout := getenv.CollectCustom[time.Duration](c, "TIMEOUT")
+
+	assert.Equal(t, "localhost", host)
+	assert.Equal(t, 0, port)
+	assert.Equal(t, []string{"a", "b"}, peers)
+	assert.Empty(t, token)
+	assert.Zero(t, timeout)
+
+	err := c.Err()
+	require.Error(t, err)
+	assert.ErrorIs(t, err, getenv.ErrNotSet)
+	assert.ErrorIs(t, err, getenv.ErrInvalidValue)
+	assert.ErrorContains(t, err, "PORT")
+	assert.ErrorContains(t, err, "TOKEN")
+	assert.ErrorContains(t, err, "TIMEOUT")
+
+	var keyErr *getenv.KeyError
+
+	require.ErrorAs(t, err, &keyErr)
+	assert.Equal(t, "PORT", keyErr.Key)
+	assert.ErrorIs(t, keyErr, getenv.ErrInvalidValue)
+
+	keys := make([]string, 0, len(c.Errors()))
+
+	for _, e := range c.Errors() {
+		keys = append(keys, e.Key)
+	}
+
+	assert.Equal(t, []string{"PORT", "TOKEN", "TIMEOUT"}, keys)
+	assert.True(t, errors.Is(c.Errors()[1], getenv.ErrNotSet))
+}
+
+func TestCollectorNoErrors(t *testing.T) {
+	c := getenv.NewCollector(option.WithSource(getenv.MapSource{"PORT": "8080"}))
+
+	assert.Equal(t, 8080, getenv.Collect[int](c, "PORT"))
+	require.NoError(t, c.Err())
+	assert.Empty(t, c.Errors())
+}
@@ -0,0 +1,73 @@
+package getenv
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/obalunenko/getenv/internal"
+	"github.com/obalunenko/getenv/option"
+)
+
+// Parameters holds parsing parameters set by options, e.g. the separator and the time layout.
+// They are passed to the parsers registered with RegisterParser.
+type Parameters = internal.Parameters
+
+// errUnsupportedType is returned when there is no parser for the requested type.
+var errUnsupportedType = errors.New("unsupported type")
+
+// RegisterParser registers parser for the user-defined type T.
+//
+// Registered types and slices of them can be read with EnvCustom and EnvCustomOrDefault
+// and bound with Bind. Slice values are split with option.WithSeparator and each element is parsed with fn.
+// Errors returned by fn are reported as ErrInvalidValue and keep the original error in the chain.
+// Registering a parser for the same type again replaces it.
+//
+// RegisterParser panics if fn is nil or T is one of the built-in supported types.
+//
+// Example:
+//
+//	type Level int
+//
+//	getenv.RegisterParser(func(raw string, _ getenv.Parameters) (Level, error) {
+//		return parseLevel(raw)
+//	})
+//
+//	level, err := getenv.EnvCustom[Level]("LOG_LEVEL")
+func RegisterParser[T any](fn func(raw string, p Parameters) (T, error)) {
+	var parse internal.CustomParseFunc
+
+	if fn != nil {
+		parse = func(raw string, p internal.Parameters) (any, error) {
+			return fn(raw, p)
+		}
+	}
+
+	internal.RegisterParser(reflect.TypeFor[T](), parse)
+}
+
+// EnvCustom retrieves the value of the environment variable named by the key.
+// Unlike Env, it accepts the types registered with RegisterParser, the types whose pointer
+// implements encoding.TextUnmarshaler and slices of them in addition to the built-in supported types,
+// and returns an error for any other type.
+func EnvCustom[T any](key string, options ...option.Option) (T, error) {
+	var t T
+
+	w, ok := internal.LookupEnvParser(reflect.Zero(reflect.TypeFor[T]()).Interface())
+	if !ok {
+		return t, fmt.Errorf("failed to parse environment variable[%s]: %w %s", key, errUnsupportedType, reflect.TypeFor[T]())
+	}
+
+	return parseEnv[T](w, key, options)
+}
+
+// EnvCustomOrDefault retrieves the value of the environment variable named by the key like EnvCustom.
+// If the variable is not present or cannot be parsed, the default value will be returned.
+func EnvCustomOrDefault[T any](key string, defaultVal T, options ...option.Option) T {
+	val, err := EnvCustom[T](key, options...)
+	if err != nil || isNilPointer(val) {
+		return defaultVal
+	}
+
+	return val
+}
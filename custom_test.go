@@ -0,0 +1,100 @@
+package getenv_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/getenv"
+	"github.com/obalunenko/getenv/option"
+)
+
+type region string
+
+var errUnknownRegion = errors.New("unknown region")
+
+var registerRegionOnce sync.Once
+
+func registerRegion() {
+	registerRegionOnce.Do(func() {
+		getenv.RegisterParser(func(raw string, _ getenv.Parameters) (region, error) {
+			switch r := region(strings.ToLower(raw)); r {
+			case "eu", "us":
+				return r, nil
+			default:
+				return "", fmt.Errorf("%q: %w", raw, errUnknownRegion)
+			}
+		})
+	})
+}
+
+func TestEnvCustom(t *testing.T) {
+	registerRegion()
+
+	src := getenv.MapSource{
+		"REGION":         "EU",
+		"REGIONS":        "eu;us",
+		"INVALID_REGION": "mars",
+		"PORT":           "8080",
+	}
+
+	got, err := getenv.EnvCustom[region]("REGION", option.WithSource(src))
+	require.NoError(t, err)
+	assert.Equal(t, region("eu"), got)
+
+	regions, err := getenv.EnvCustom[[]region]("REGIONS", option.WithSource(src), option.WithSeparator(";"))
+	require.NoError(t, err)
+	assert.Equal(t, []region{"eu", "us"}, regions)
+
+	_, err = getenv.EnvCustom[region]("INVALID_REGION", option.WithSource(src))
+	errorEqual(getenv.ErrInvalidValue)(t, err)
+	assert.ErrorIs(t, err, errUnknownRegion)
+
+	_, err = getenv.EnvCustom[region]("MISSING", option.WithSource(src))
+	errorEqual(getenv.ErrNotSet)(t, err)
+
+	port, err := getenv.EnvCustom[int]("PORT", option.WithSource(src))
+	require.NoError(t, err)
+	assert.Equal(t, 8080, port)
+
+	_, err = getenv.EnvCustom[chan int]("PORT", option.WithSource(src))
+	assert.ErrorContains(t, err, "unsupported type chan int")
+
+	assert.Equal(t, region("us"), getenv.EnvCustomOrDefault("MISSING", region("us"), option.WithSource(src)))
+}
+
+func TestBindCustom(t *testing.T) {
+	registerRegion()
+
+	type config struct {
+		Region  region   `env:"REGION"`
+		Regions []region `env:"REGIONS" sep:","`
+	}
+
+	src := getenv.MapSource{
+		"REGION":  "us",
+		"REGIONS": "us,eu",
+	}
+
+	var cfg config
+
+	require.NoError(t, getenv.Bind(&cfg, option.WithSource(src)))
+	assert.Equal(t, config{Region: "us", Regions: []region{"us", "eu"}}, cfg)
+}
+
+func TestRegisterParserPanics(t *testing.T) {
+	assert.Panics(t, func() {
+		getenv.RegisterParser[region](nil)
+	})
+
+	assert.Panics(t, func() {
+		getenv.RegisterParser(func(string, getenv.Parameters) (int, error) {
+			return 0, nil
+		})
+	})
+}
@@ -0,0 +1,426 @@
+// Package dotenv provides a parser for .env files.
+//
+// The parsed values can be used as a getenv.Source or loaded into the process environment.
+//
+// Supported syntax:
+//   - blank lines and lines starting with # are ignored;
+//   - KEY=value pairs, with an optional "export " prefix;
+//   - unquoted values are trimmed, a # preceded by whitespace starts an inline comment;
+//   - single-quoted values are taken literally;
+//   - double-quoted values support the \n, \r, \t, \\, \", \' and \$ escape sequences;
+//   - quoted values may span multiple lines.
+package dotenv
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/obalunenko/getenv"
+)
+
+// SyntaxError is an error that is returned when the input is not a valid .env file.
+type SyntaxError struct {
+	// File is the name of the file, empty when parsing a reader.
+	File string
+	// Line is the 1-based line number of the error.
+	Line int
+	// Column is the 1-based column number of the error.
+	Column int
+	// Msg describes the error.
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	if e.File == "" {
+		return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Msg)
+	}
+
+	return fmt.Sprintf("%s:%d:%d: %s", e.File, e.Line, e.Column, e.Msg)
+}
+
+// Parse reads .env formatted data from r and returns the parsed values.
+func Parse(r io.Reader) (map[string]string, error) {
+	return parse(r, "")
+}
+
+// Read parses the named files and returns the merged values.
+// Values from the later files override values from the earlier ones.
+// When no file is given, .env in the current directory is read.
+func Read(filenames ...string) (map[string]string, error) {
+	if len(filenames) == 0 {
+		filenames = []string{".env"}
+	}
+
+	res := make(map[string]string)
+
+	for _, filename := range filenames {
+		values, err := readFile(filename)
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range values {
+			res[k] = v
+		}
+	}
+
+	return res, nil
+}
+
+// Source parses the named files and returns the merged values as getenv.MapSource,
+// ready to be passed to getenv.Env with option.WithSource.
+func Source(filenames ...string) (getenv.MapSource, error) {
+	values, err := Read(filenames...)
+	if err != nil {
+		return nil, err
+	}
+
+	return getenv.MapSource(values), nil
+}
+
+// Load parses the named files and sets the values in the process environment.
+// Variables that are already present in the environment are not overridden.
+func Load(filenames ...string) error {
+	return load(false, filenames)
+}
+
+// Overload parses the named files and sets the values in the process environment,
+// overriding variables that are already present.
+func Overload(filenames ...string) error {
+	return load(true, filenames)
+}
+
+func load(override bool, filenames []string) error {
+	values, err := Read(filenames...)
+	if err != nil {
+		return err
+	}
+
+	for k, v := range values {
+		if _, ok := os.LookupEnv(k); ok && !override {
+			continue
+		}
+
+		if err = os.Setenv(k, v); err != nil {
+			return fmt.Errorf("failed to set environment variable[%s]: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+func readFile(filename string) (map[string]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open dotenv file: %w", err)
+	}
+
+	defer func() {
+		_ = f.Close()
+	}()
+
+	return parse(f, filename)
+}
+
+func parse(r io.Reader, filename string) (map[string]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dotenv data: %w", err)
+	}
+
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	data = bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+
+	p := parser{
+		src:  []rune(string(data)),
+		file: filename,
+		line: 1,
+		col:  1,
+	}
+
+	return p.parse()
+}
+
+// parser is a .env data parser that keeps track of the current position.
+type parser struct {
+	src  []rune
+	pos  int
+	line int
+	col  int
+	file string
+}
+
+func (p *parser) parse() (map[string]string, error) {
+	res := make(map[string]string)
+
+	for {
+		p.skipBlanks()
+
+		if p.eof() {
+			return res, nil
+		}
+
+		switch p.peek() {
+		case '\n':
+			p.next()
+
+			continue
+		case '#':
+			p.skipLine()
+
+			continue
+		}
+
+		key, err := p.readKey()
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipBlanks()
+
+		if p.eof() || p.peek() != '=' {
+			return nil, p.errorf("expected '=' after key %q", key)
+		}
+
+		p.next()
+		p.skipBlanks()
+
+		val, err := p.readValue()
+		if err != nil {
+			return nil, err
+		}
+
+		res[key] = val
+	}
+}
+
+// readKey reads the variable name, skipping an optional export prefix.
+func (p *parser) readKey() (string, error) {
+	key, err := p.readIdent()
+	if err != nil {
+		return "", err
+	}
+
+	if key == "export" && !p.eof() && isBlank(p.peek()) {
+		p.skipBlanks()
+
+		return p.readIdent()
+	}
+
+	return key, nil
+}
+
+func (p *parser) readIdent() (string, error) {
+	if p.eof() || !isIdentStart(p.peek()) {
+		return "", p.unexpected("invalid key")
+	}
+
+	var b strings.Builder
+
+	for !p.eof() && isIdent(p.peek()) {
+		b.WriteRune(p.next())
+	}
+
+	return b.String(), nil
+}
+
+func (p *parser) readValue() (string, error) {
+	if p.eof() {
+		return "", nil
+	}
+
+	var (
+		val string
+		err error
+	)
+
+	switch p.peek() {
+	case '"':
+		val, err = p.readDoubleQuoted()
+	case '\'':
+		val, err = p.readSingleQuoted()
+	default:
+		return p.readUnquoted(), nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	p.skipBlanks()
+
+	if p.eof() {
+		return val, nil
+	}
+
+	switch p.peek() {
+	case '\n':
+		p.next()
+	case '#':
+		p.skipLine()
+	default:
+		return "", p.unexpected("unexpected character after quoted value")
+	}
+
+	return val, nil
+}
+
+func (p *parser) readUnquoted() string {
+	var b strings.Builder
+
+	// The value is always preceded by '=' or blanks.
+	prev := p.src[p.pos-1]
+
+	for !p.eof() && p.peek() != '\n' {
+		if p.peek() == '#' && isBlank(prev) {
+			p.skipLine()
+
+			break
+		}
+
+		prev = p.next()
+		b.WriteRune(prev)
+	}
+
+	if !p.eof() && p.peek() == '\n' {
+		p.next()
+	}
+
+	return strings.TrimRight(b.String(), " \t")
+}
+
+func (p *parser) readSingleQuoted() (string, error) {
+	line, col := p.line, p.col
+
+	p.next()
+
+	var b strings.Builder
+
+	for !p.eof() {
+		r := p.next()
+		if r == '\'' {
+			return b.String(), nil
+		}
+
+		b.WriteRune(r)
+	}
+
+	return "", p.errorAt(line, col, "unterminated single-quoted value")
+}
+
+func (p *parser) readDoubleQuoted() (string, error) {
+	line, col := p.line, p.col
+
+	p.next()
+
+	var b strings.Builder
+
+	for !p.eof() {
+		r := p.next()
+
+		switch r {
+		case '"':
+			return b.String(), nil
+		case '\\':
+			if p.eof() {
+				continue
+			}
+
+			esc, ok := escapes[p.peek()]
+			if !ok {
+				return "", p.unexpected("invalid escape sequence")
+			}
+
+			p.next()
+			b.WriteRune(esc)
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return "", p.errorAt(line, col, "unterminated double-quoted value")
+}
+
+// escapes maps the characters following a backslash in double-quoted values to their values.
+var escapes = map[rune]rune{
+	'n':  '\n',
+	'r':  '\r',
+	't':  '\t',
+	'\\': '\\',
+	'"':  '"',
+	'\'': '\'',
+	'$':  '$',
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *parser) peek() rune {
+	return p.src[p.pos]
+}
+
+func (p *parser) next() rune {
+	r := p.src[p.pos]
+	p.pos++
+
+	if r == '\n' {
+		p.line++
+		p.col = 1
+	} else {
+		p.col++
+	}
+
+	return r
+}
+
+func (p *parser) skipBlanks() {
+	for !p.eof() && isBlank(p.peek()) {
+		p.next()
+	}
+}
+
+// skipLine skips the rest of the current line including the line break.
+func (p *parser) skipLine() {
+	for !p.eof() {
+		if p.next() == '\n' {
+			return
+		}
+	}
+}
+
+func (p *parser) unexpected(msg string) error {
+	if p.eof() {
+		return p.errorf("%s: unexpected end of input", msg)
+	}
+
+	return p.errorf("%s: unexpected character %q", msg, p.peek())
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return p.errorAt(p.line, p.col, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) errorAt(line, col int, msg string) error {
+	return &SyntaxError{
+		File:   p.file,
+		Line:   line,
+		Column: col,
+		Msg:    msg,
+	}
+}
+
+func isBlank(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\r'
+}
+
+func isIdentStart(r rune) bool {
+	return r == '_' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+}
+
+func isIdent(r rune) bool {
+	return isIdentStart(r) || r == '.' || ('0' <= r && r <= '9')
+}
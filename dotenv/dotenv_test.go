@@ -0,0 +1,259 @@
+package dotenv_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/getenv"
+	"github.com/obalunenko/getenv/dotenv"
+	"github.com/obalunenko/getenv/option"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected map[string]string
+	}{
+		{
+			name:     "empty",
+			input:    "",
+			expected: map[string]string{},
+		},
+		{
+			name: "comments and blank lines",
+			input: "# comment\n" +
+				"\n" +
+				"   # indented comment\n" +
+				"KEY=value\n",
+			expected: map[string]string{
+				"KEY": "value",
+			},
+		},
+		{
+			name: "unquoted values",
+			input: "A=plain\n" +
+				"B = spaced value  \n" +
+				"C=value # inline comment\n" +
+				"D=#not-a-comment\n" +
+				"E=\n" +
+				"F=a=b\n" +
+				"G.H_1=dotted",
+			expected: map[string]string{
+				"A":     "plain",
+				"B":     "spaced value",
+				"C":     "value",
+				"D":     "#not-a-comment",
+				"E":     "",
+				"F":     "a=b",
+				"G.H_1": "dotted",
+			},
+		},
+		{
+			name: "export prefix",
+			input: "export A=1\n" +
+				"export\tB='2'\n" +
+				"export=3\n",
+			expected: map[string]string{
+				"A":      "1",
+				"B":      "2",
+				"export": "3",
+			},
+		},
+		{
+			name: "single quotes",
+			input: `A='literal \n $HOME # not comment'` + "\n" +
+				`B='with "double"' # comment` + "\n",
+			expected: map[string]string{
+				"A": `literal \n $HOME # not comment`,
+				"B": `with "double"`,
+			},
+		},
+		{
+			name: "double quotes and escapes",
+			input: `A="line1\nline2\ttab \"quoted\" \\ \$HOME \'"` + "\n" +
+				`B="# not comment" # comment` + "\n",
+			expected: map[string]string{
+				"A": "line1\nline2\ttab \"quoted\" \\ $HOME '",
+				"B": "# not comment",
+			},
+		},
+		{
+			name: "multiline",
+			input: "CERT=\"-----BEGIN-----\r\n" +
+				"abc\r\n" +
+				"-----END-----\"\r\n" +
+				"NEXT=1\r\n",
+			expected: map[string]string{
+				"CERT": "-----BEGIN-----\nabc\n-----END-----",
+				"NEXT": "1",
+			},
+		},
+		{
+			name:  "byte order mark",
+			input: "\xef\xbb\xbfKEY=value",
+			expected: map[string]string{
+				"KEY": "value",
+			},
+		},
+		{
+			name: "later value wins",
+			input: "KEY=first\n" +
+				"KEY=second\n",
+			expected: map[string]string{
+				"KEY": "second",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dotenv.Parse(strings.NewReader(tt.input))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestParseSyntaxError(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected dotenv.SyntaxError
+	}{
+		{
+			name:  "missing equals",
+			input: "A=1\nKEY value\n",
+			expected: dotenv.SyntaxError{
+				Line:   2,
+				Column: 5,
+				Msg:    `expected '=' after key "KEY"`,
+			},
+		},
+		{
+			name:  "invalid key",
+			input: "1KEY=value",
+			expected: dotenv.SyntaxError{
+				Line:   1,
+				Column: 1,
+				Msg:    `invalid key: unexpected character '1'`,
+			},
+		},
+		{
+			name:  "unterminated double quote",
+			input: "A=1\nB=\"open\nstill open",
+			expected: dotenv.SyntaxError{
+				Line:   2,
+				Column: 3,
+				Msg:    "unterminated double-quoted value",
+			},
+		},
+		{
+			name:  "unterminated single quote",
+			input: "A='open",
+			expected: dotenv.SyntaxError{
+				Line:   1,
+				Column: 3,
+				Msg:    "unterminated single-quoted value",
+			},
+		},
+		{
+			name:  "invalid escape",
+			input: `A="bad \q"`,
+			expected: dotenv.SyntaxError{
+				Line:   1,
+				Column: 9,
+				Msg:    `invalid escape sequence: unexpected character 'q'`,
+			},
+		},
+		{
+			name:  "trailing characters after quoted value",
+			input: `A="value"trailing`,
+			expected: dotenv.SyntaxError{
+				Line:   1,
+				Column: 10,
+				Msg:    `unexpected character after quoted value: unexpected character 't'`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := dotenv.Parse(strings.NewReader(tt.input))
+
+			var syntaxErr *dotenv.SyntaxError
+
+			require.True(t, errors.As(err, &syntaxErr))
+			assert.Equal(t, tt.expected, *syntaxErr)
+		})
+	}
+}
+
+func writeFile(tb testing.TB, content string) string {
+	tb.Helper()
+
+	path := filepath.Join(tb.TempDir(), ".env")
+
+	require.NoError(tb, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestRead(t *testing.T) {
+	base := writeFile(t, "A=1\nB=2\n")
+	local := writeFile(t, "B=3\n")
+
+	got, err := dotenv.Read(base, local)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"A": "1", "B": "3"}, got)
+
+	_, err = dotenv.Read(filepath.Join(t.TempDir(), "missing.env"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	broken := writeFile(t, "A")
+
+	_, err = dotenv.Read(broken)
+	assert.EqualError(t, err, broken+`:1:2: expected '=' after key "A"`)
+}
+
+func TestSource(t *testing.T) {
+	path := writeFile(t, "PORT=8080\nPEERS=\"a,b\"\n")
+
+	src, err := dotenv.Source(path)
+	require.NoError(t, err)
+
+	port, err := getenv.Env[int]("PORT", option.WithSource(src))
+	require.NoError(t, err)
+	assert.Equal(t, 8080, port)
+
+	peers, err := getenv.Env[[]string]("PEERS", option.WithSource(src), option.WithSeparator(","))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, peers)
+}
+
+func TestLoad(t *testing.T) {
+	const (
+		existing = "GH_GETENV_DOTENV_EXISTING"
+		fresh    = "GH_GETENV_DOTENV_FRESH"
+	)
+
+	t.Setenv(existing, "env")
+	t.Cleanup(func() {
+		require.NoError(t, os.Unsetenv(fresh))
+	})
+
+	path := writeFile(t, existing+"=file\n"+fresh+"=file\n")
+
+	require.NoError(t, dotenv.Load(path))
+	assert.Equal(t, "env", os.Getenv(existing))
+	assert.Equal(t, "file", os.Getenv(fresh))
+
+	require.NoError(t, dotenv.Overload(path))
+	assert.Equal(t, "file", os.Getenv(existing))
+}
@@ -0,0 +1,91 @@
+package getenv
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/obalunenko/getenv/internal"
+)
+
+// redacted replaces the raw value of the variables marked with option.WithSensitive.
+const redacted = "[REDACTED]"
+
+// ParseError describes the value of the environment variable that failed to parse.
+// It is reachable with errors.As from the errors matching ErrInvalidValue and unwraps to the cause,
+// e.g. *strconv.NumError.
+//
+// For the variables marked with option.WithSensitive, Raw is "[REDACTED]" and the error message
+// does not contain the value, note that the cause still may.
+type ParseError struct {
+	// Key is the name of the environment variable.
+	Key string
+	// Raw is the raw value that failed to parse: the value of the variable or, for slice elements
+	// and map pairs, the failing element.
+	Raw string
+	// TargetType is the type the value is parsed into.
+	TargetType reflect.Type
+	// Index is the index of the failing slice element or map pair, or -1.
+	// For two-level slices, it is the index of the row.
+	Index int
+	// Column is the index of the failing element in the row of two-level slices, or -1.
+	Column int
+	// Err is the cause of the error.
+	Err error
+
+	sensitive bool
+}
+
+func (e *ParseError) Error() string {
+	if !e.sensitive {
+		return e.Err.Error()
+	}
+
+	if e.Column >= 0 {
+		return fmt.Sprintf("element [%d][%d]: cannot parse %s value as %s: %s",
+			e.Index, e.Column, redacted, e.TargetType, ErrInvalidValue)
+	}
+
+	if e.Index >= 0 {
+		return fmt.Sprintf("element [%d]: cannot parse %s value as %s: %s", e.Index, redacted, e.TargetType, ErrInvalidValue)
+	}
+
+	return fmt.Sprintf("cannot parse %s value as %s: %s", redacted, e.TargetType, ErrInvalidValue)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// newParseError wraps invalid value error of the variable named by the key into *ParseError,
+// other errors are returned unchanged.
+func newParseError(key string, t reflect.Type, params internal.Parameters, err error) error {
+	if !errors.Is(err, internal.ErrInvalidValue) {
+		return err
+	}
+
+	pe := &ParseError{
+		Key:        key,
+		TargetType: t,
+		Index:      -1,
+		Column:     -1,
+		Err:        err,
+		sensitive:  params.Sensitive,
+	}
+
+	var elemErr *internal.ElementError
+
+	if errors.As(err, &elemErr) {
+		pe.Index = elemErr.Index
+		pe.Column = elemErr.Column
+		pe.Raw = elemErr.Raw
+	} else {
+		pe.Raw, _ = internal.RawValue(key, params)
+	}
+
+	if pe.sensitive {
+		pe.Raw = redacted
+	}
+
+	return pe
+}
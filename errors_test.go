@@ -0,0 +1,264 @@
+package getenv_test
+
+import (
+	"errors"
+	"net"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/getenv"
+	"github.com/obalunenko/getenv/option"
+)
+
+func TestParseError(t *testing.T) {
+	src := getenv.MapSource{
+		"PORT":    "80s",
+		"PORTS":   "80,8o,443",
+		"LIMITS":  "a=1,b=x",
+		"TIMEOUT": "soon",
+		"SHARDS":  "1,2;3,x",
+	}
+
+	type expected struct {
+		raw        string
+		targetType reflect.Type
+		index      int
+		column     int
+	}
+
+	tests := []struct {
+		name     string
+		parse    func() error
+		expected expected
+	}{
+		{
+			name: "scalar",
+			parse: func() error {
+				_, err := getenv.Env[int]("PORT", option.WithSource(src))
+
+				return err
+			},
+			expected: expected{
+				raw:        "80s",
+				targetType: reflect.TypeFor[int](),
+				index:      -1,
+				column:     -1,
+			},
+		},
+		{
+			name: "slice element",
+			parse: func() error {
+				_, err := getenv.Env[[]uint16]("PORTS", option.WithSource(src), option.WithSeparator(","))
+
+				return err
+			},
+			expected: expected{
+				raw:        "8o",
+				targetType: reflect.TypeFor[[]uint16](),
+				index:      1,
+				column:     -1,
+			},
+		},
+		{
+			name: "map pair",
+			parse: func() error {
+				_, err := getenv.EnvMap[string, int]("LIMITS",
+					option.WithSource(src), option.WithSeparator(","), option.WithKeyValueSeparator("="))
+
+				return err
+			},
+			expected: expected{
+				raw:        "b=x",
+				targetType: reflect.TypeFor[map[string]int](),
+				index:      1,
+				column:     -1,
+			},
+		},
+		{
+			name: "two-level slice element",
+			parse: func() error {
+				_, err := getenv.EnvCustom[[][]int]("SHARDS",
+					option.WithSource(src), option.WithSeparator(","), option.WithOuterSeparator(";"))
+
+				return err
+			},
+			expected: expected{
+				raw:        "x",
+				targetType: reflect.TypeFor[[][]int](),
+				index:      1,
+				column:     1,
+			},
+		},
+		{
+			name: "sensitive",
+			parse: func() error {
+				_, err := getenv.Env[time.Duration]("TIMEOUT", option.WithSource(src), option.WithSensitive())
+
+				return err
+			},
+			expected: expected{
+				raw:        "[REDACTED]",
+				targetType: reflect.TypeFor[time.Duration](),
+				index:      -1,
+				column:     -1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.parse()
+			errorEqual(getenv.ErrInvalidValue)(t, err)
+
+			var pe *getenv.ParseError
+
+			require.ErrorAs(t, err, &pe)
+			assert.Equal(t, tt.expected.raw, pe.Raw)
+			assert.Equal(t, tt.expected.targetType, pe.TargetType)
+			assert.Equal(t, tt.expected.index, pe.Index)
+			assert.Equal(t, tt.expected.column, pe.Column)
+		})
+	}
+}
+
+func TestParseErrorCause(t *testing.T) {
+	src := getenv.MapSource{
+		"PORT":     "80s",
+		"PASSWORD": "hunter2",
+	}
+
+	_, err := getenv.Env[int]("PORT", option.WithSource(src))
+
+	var numErr *strconv.NumError
+
+	require.ErrorAs(t, err, &numErr)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+
+	var pe *getenv.ParseError
+
+	require.ErrorAs(t, err, &pe)
+	assert.Equal(t, "PORT", pe.Key)
+	assert.True(t, errors.Is(pe, strconv.ErrSyntax))
+
+	_, err = getenv.Env[int]("PASSWORD", option.WithSource(src), option.WithSensitive())
+	errorEqual(getenv.ErrInvalidValue)(t, err)
+	assert.NotContains(t, err.Error(), "hunter2")
+	assert.ErrorContains(t, err, "PASSWORD")
+
+	_, err = getenv.Env[int]("MISSING", option.WithSource(src))
+	errorEqual(getenv.ErrNotSet)(t, err)
+	assert.False(t, errors.As(err, &pe))
+}
+
+func TestBindParseError(t *testing.T) {
+	type config struct {
+		Token int `env:"GH_GETENV_BIND_SECRET_TOKEN,sensitive"`
+	}
+
+	t.Setenv("GH_GETENV_BIND_SECRET_TOKEN", "hunter2")
+
+	var cfg config
+
+	err := getenv.Bind(&cfg)
+	errorEqual(getenv.ErrInvalidValue)(t, err)
+	assert.NotContains(t, err.Error(), "hunter2")
+
+	var pe *getenv.ParseError
+
+	require.ErrorAs(t, err, &pe)
+	assert.Equal(t, "GH_GETENV_BIND_SECRET_TOKEN", pe.Key)
+	assert.Equal(t, "[REDACTED]", pe.Raw)
+	assert.Equal(t, reflect.TypeFor[int](), pe.TargetType)
+}
+
+func TestSentinelErrors(t *testing.T) {
+	src := getenv.MapSource{
+		"PORT":   "70000",
+		"HOST":   "local host",
+		"PEERS":  "1,,2",
+		"TIME":   "2020-01-01",
+		"SHARDS": "a,b;c",
+	}
+
+	tests := []struct {
+		name         string
+		parse        func() error
+		kind         error
+		invalidValue bool
+	}{
+		{
+			name: "out of range",
+			parse: func() error {
+				_, err := getenv.Env[uint16]("PORT", option.WithSource(src))
+
+				return err
+			},
+			kind:         getenv.ErrOutOfRange,
+			invalidValue: true,
+		},
+		{
+			name: "syntax",
+			parse: func() error {
+				_, err := getenv.Env[net.IP]("HOST", option.WithSource(src))
+
+				return err
+			},
+			kind:         getenv.ErrSyntax,
+			invalidValue: true,
+		},
+		{
+			name: "empty element",
+			parse: func() error {
+				_, err := getenv.Env[[]int]("PEERS", option.WithSource(src), option.WithSeparator(","))
+
+				return err
+			},
+			kind:         getenv.ErrEmptyElement,
+			invalidValue: true,
+		},
+		{
+			name: "missing separator",
+			parse: func() error {
+				_, err := getenv.EnvSlices[string]("SHARDS", option.WithSource(src), option.WithSeparator(","))
+
+				return err
+			},
+			kind:         getenv.ErrMissingSeparator,
+			invalidValue: false,
+		},
+		{
+			name: "missing layout",
+			parse: func() error {
+				_, err := getenv.Env[time.Time]("TIME", option.WithSource(src))
+
+				return err
+			},
+			kind:         getenv.ErrMissingLayout,
+			invalidValue: false,
+		},
+		{
+			name: "invalid base",
+			parse: func() error {
+				_, err := getenv.Env[int]("PORT", option.WithSource(src), option.WithBase(1))
+
+				return err
+			},
+			kind:         getenv.ErrInvalidBase,
+			invalidValue: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.parse()
+			require.ErrorIs(t, err, tt.kind)
+			assert.Equal(t, tt.invalidValue, errors.Is(err, getenv.ErrInvalidValue))
+			assert.NotErrorIs(t, err, getenv.ErrNotSet)
+		})
+	}
+}
@@ -35,6 +35,7 @@
 // - []time.Time
 // - time.Duration
 // - []time.Duration
+// - *time.Location
 // - bool
 // - []bool
 // - url.URL
@@ -51,11 +52,26 @@
 // - []complex64
 // - complex128
 // - []complex128
+// - ByteSize
+// - []ByteSize
+// - pointers to the types above, e.g. *int, *time.Duration or *[]string, except []ByteSize
+//
+// Pointer types express optional values: when the variable is not set, Env returns nil pointer
+// and no error, otherwise it returns pointer to the parsed value. *time.Location follows the same rule.
+// Pointers to []ByteSize are supported by EnvCustom and Bind.
+//
+// Maps, e.g. map[string]int, are supported by EnvMap, EnvCustom and Bind, Env does not accept them
+// to keep its type constraint within the compiler limit of 100 types.
+// Map values are split into pairs with option.WithPairSeparator or option.WithSeparator
+// and into keys and values with option.WithKeyValueSeparator.
+//
+// Two-level slices of the scalar types, e.g. [][]string, are supported by EnvSlices, EnvCustom and Bind.
 package getenv
 
 import (
 	"errors"
 	"fmt"
+	"reflect"
 
 	"github.com/obalunenko/getenv/internal"
 	"github.com/obalunenko/getenv/option"
@@ -66,35 +82,79 @@
 	ErrNotSet = errors.New("not set")
 	// ErrInvalidValue is an error that is returned when the environment variable is not valid.
 	ErrInvalidValue = errors.New("invalid value")
+	// ErrOutOfRange is an error that is returned when the value is out of range of the type,
+	// e.g. 300 for uint8. Errors matching it also match ErrInvalidValue.
+	ErrOutOfRange = errors.New("out of range")
+	// ErrSyntax is an error that is returned when the value has invalid syntax for the type,
+	// e.g. "80s" for int. Errors matching it also match ErrInvalidValue.
+	ErrSyntax = errors.New("invalid syntax")
+	// ErrEmptyElement is an error that is returned when the element of the slice or the map is empty,
+	// e.g. "1,,2" for []int. Errors matching it also match ErrInvalidValue.
+	ErrEmptyElement = errors.New("empty element")
+	// ErrNotAllowed is an error that is returned when the value is not one of the values allowed
+	// with option.WithOneOf. Errors matching it also match ErrInvalidValue.
+	ErrNotAllowed = errors.New("not allowed")
+	// ErrMissingSeparator is an error that is returned when the separator required by the type is not set
+	// with the options. It is a programmer error and does not match ErrInvalidValue.
+	ErrMissingSeparator = errors.New("missing separator")
+	// ErrMissingLayout is an error that is returned when the time layout is not set with option.WithTimeLayout,
+	// option.WithTimeLayouts or option.WithAutoTimeLayout.
+	// It is a programmer error and does not match ErrInvalidValue.
+	ErrMissingLayout = errors.New("missing time layout")
+	// ErrInvalidBase is an error that is returned when the integer base set with option.WithBase
+	// is not 0 or from 2 to 36. It is a programmer error and does not match ErrInvalidValue.
+	ErrInvalidBase = errors.New("invalid base")
 )
 
+// sentinels maps internal sentinel errors to the exported ones.
+var sentinels = []struct {
+	internal error
+	public   error
+}{
+	{internal: internal.ErrNotSet, public: ErrNotSet},
+	{internal: internal.ErrInvalidValue, public: ErrInvalidValue},
+	{internal: internal.ErrOutOfRange, public: ErrOutOfRange},
+	{internal: internal.ErrSyntax, public: ErrSyntax},
+	{internal: internal.ErrEmptyElement, public: ErrEmptyElement},
+	{internal: internal.ErrNotAllowed, public: ErrNotAllowed},
+	{internal: internal.ErrMissingSeparator, public: ErrMissingSeparator},
+	{internal: internal.ErrMissingLayout, public: ErrMissingLayout},
+	{internal: internal.ErrInvalidBase, public: ErrInvalidBase},
+}
+
 // Env retrieves the value of the environment variable named by the key.
 // If the variable is present in the environment, the value will be parsed and returned.
-// Otherwise, an error will be returned.
+// Otherwise, an error will be returned, unless T is a pointer type: then nil pointer is returned.
 func Env[T internal.EnvParsable](key string, options ...option.Option) (T, error) {
 	var t T
 
 	w := internal.NewEnvParser(t)
 
+	return parseEnv[T](w, key, options)
+}
+
+// EnvOrDefault retrieves the value of the environment variable named by the key.
+// If the variable is present in the environment the value will be parsed and returned.
+// Otherwise, the default value will be returned.
+// The value returned will be of the same type as the default value.
+func EnvOrDefault[T internal.EnvParsable](key string, defaultVal T, options ...option.Option) T {
+	val, err := Env[T](key, options...)
+	if err != nil || isNilPointer(val) {
+		return defaultVal
+	}
+
+	return val
+}
+
+// parseEnv parses the environment variable named by key with the parser.
+func parseEnv[T any](w internal.EnvParser, key string, options []option.Option) (T, error) {
+	var t T
+
 	params := newParseParams(options)
 
 	val, err := w.ParseEnv(key, params)
 	if err != nil {
-		if errors.Is(err, internal.ErrNotSet) {
-			return t, fmt.Errorf("failed to get environment variable[%s]: %w", key, publicError{
-				cause:    err,
-				sentinel: ErrNotSet,
-			})
-		}
-
-		if errors.Is(err, internal.ErrInvalidValue) {
-			return t, fmt.Errorf("failed to parse environment variable[%s]: %w", key, publicError{
-				cause:    err,
-				sentinel: ErrInvalidValue,
-			})
-		}
-
-		return t, fmt.Errorf("failed to parse environment variable[%s]: %w", key, err)
+		return t, newEnvError(key, newParseError(key, reflect.TypeFor[T](), params, err))
 	}
 
 	res, ok := val.(T)
@@ -105,23 +165,43 @@ func Env[T internal.EnvParsable](key string, options ...option.Option) (T, error
 	return res, nil
 }
 
-// EnvOrDefault retrieves the value of the environment variable named by the key.
-// If the variable is present in the environment the value will be parsed and returned.
-// Otherwise, the default value will be returned.
-// The value returned will be of the same type as the default value.
-func EnvOrDefault[T internal.EnvParsable](key string, defaultVal T, options ...option.Option) T {
-	val, err := Env[T](key, options...)
-	if err != nil {
-		return defaultVal
+// isNilPointer reports whether v is a nil pointer, which stands for the variable that is not set.
+func isNilPointer(v any) bool {
+	rv := reflect.ValueOf(v)
+
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
+
+// newEnvError wraps parser error for the key, mapping internal sentinels to exported ones.
+func newEnvError(key string, err error) error {
+	var public []error
+
+	for _, s := range sentinels {
+		if errors.Is(err, s.internal) {
+			public = append(public, s.public)
+		}
 	}
 
-	return val
+	if len(public) == 0 {
+		return fmt.Errorf("failed to parse environment variable[%s]: %w", key, err)
+	}
+
+	wrapped := publicError{
+		cause:     err,
+		sentinels: public,
+	}
+
+	if errors.Is(err, internal.ErrNotSet) {
+		return fmt.Errorf("failed to get environment variable[%s]: %w", key, wrapped)
+	}
+
+	return fmt.Errorf("failed to parse environment variable[%s]: %w", key, wrapped)
 }
 
 // publicError keeps parser details while matching exported sentinels.
 type publicError struct {
-	cause    error
-	sentinel error
+	cause     error
+	sentinels []error
 }
 
 func (e publicError) Error() string {
@@ -129,7 +209,7 @@ func (e publicError) Error() string {
 }
 
 func (e publicError) Unwrap() []error {
-	return []error{e.cause, e.sentinel}
+	return append([]error{e.cause}, e.sentinels...)
 }
 
 // newParseParams creates new parameters from options.
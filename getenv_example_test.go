@@ -251,3 +251,41 @@ func ExampleEnv() {
 	// [complex128]: (1+2i); err: <nil>
 	// [[]complex64]: [(1+2i) (3+4i)]; err: <nil>
 }
+
+func ExampleBind() {
+	type config struct {
+		Host    string        `env:"GH_GETENV_EXAMPLE_HOST"`
+		Port    int           `env:"GH_GETENV_EXAMPLE_PORT"`
+		Peers   []string      `env:"GH_GETENV_EXAMPLE_PEERS" sep:","`
+		Timeout time.Duration `env:"GH_GETENV_EXAMPLE_TIMEOUT"`
+	}
+
+	env := map[string]string{
+		"GH_GETENV_EXAMPLE_HOST":    "localhost",
+		"GH_GETENV_EXAMPLE_PORT":    "8080",
+		"GH_GETENV_EXAMPLE_PEERS":   "a,b,c",
+		"GH_GETENV_EXAMPLE_TIMEOUT": "30s",
+	}
+
+	for k, v := range env {
+		if err := os.Setenv(k, v); err != nil {
+			panic(err)
+		}
+	}
+
+	defer func() {
+		for k := range env {
+			if err := os.Unsetenv(k); err != nil {
+				panic(err)
+			}
+		}
+	}()
+
+	var cfg config
+
+	err := getenv.Bind(&cfg)
+	fmt.Printf("%+v; err: %v\n", cfg, err)
+
+	// Output:
+	// {Host:localhost Port:8080 Peers:[a b c] Timeout:30s}; err: <nil>
+}
@@ -5,6 +5,9 @@
 	"net"
 	"net/netip"
 	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
 	"time"
 
@@ -4433,6 +4436,42 @@ func TestEnvErrorSentinels(t *testing.T) {
 	assert.True(t, errors.Is(err, getenv.ErrNotSet))
 }
 
+func TestEnvFileFallback(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "port")
+	require.NoError(t, os.WriteFile(path, []byte("5432\n"), 0o600))
+
+	t.Setenv(testEnvKey+"_FILE", path)
+
+	got, err := getenv.Env[int](testEnvKey, option.WithFileFallback())
+	require.NoError(t, err)
+	assert.Equal(t, 5432, got)
+
+	_, err = getenv.Env[int](testEnvKey)
+	assert.ErrorIs(t, err, getenv.ErrNotSet)
+
+	t.Setenv(testEnvKey, "5433")
+
+	_, err = getenv.Env[int](testEnvKey, option.WithFileFallback())
+	assert.ErrorIs(t, err, getenv.ErrInvalidValue)
+}
+
+func TestEnvExpand(t *testing.T) {
+	src := getenv.MapSource{
+		"API_HOST": "example.com",
+		"API_URL":  "https://${API_HOST}:${API_PORT:-8443}/${API_PATH:?path is required}",
+	}
+
+	_, err := getenv.Env[url.URL]("API_URL", option.WithSource(src), option.WithExpand())
+	assert.ErrorIs(t, err, getenv.ErrInvalidValue)
+	assert.ErrorContains(t, err, "API_PATH: path is required")
+
+	src["API_PATH"] = "v1"
+
+	got, err := getenv.Env[url.URL]("API_URL", option.WithSource(src), option.WithExpand())
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com:8443/v1", got.String())
+}
+
 // TestEnvIntSlice tests the Env function with a []int.
 func TestEnvIntSlice(t *testing.T) {
 	type args struct {
@@ -4559,3 +4598,191 @@ func errorEqual(expected error) assert.ErrorAssertionFunc {
 			assert.ErrorContains(t, err, expected.Error(), i...)
 	}
 }
+
+func TestEnvBase(t *testing.T) {
+	src := getenv.MapSource{
+		"PERM":  "0644",
+		"MASKS": "0xFF00,0b1010,1_000",
+		"HEX":   "ff",
+	}
+
+	perm, err := getenv.Env[uint32]("PERM", option.WithSource(src), option.WithBase(0))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0o644), perm)
+
+	masks, err := getenv.Env[[]int]("MASKS", option.WithSource(src), option.WithBase(0), option.WithSeparator(","))
+	require.NoError(t, err)
+	assert.Equal(t, []int{0xFF00, 0b1010, 1000}, masks)
+
+	hex, err := getenv.Env[uint8]("HEX", option.WithSource(src), option.WithBase(16))
+	require.NoError(t, err)
+	assert.Equal(t, uint8(0xff), hex)
+
+	_, err = getenv.Env[uint8]("HEX", option.WithSource(src))
+	errorEqual(getenv.ErrInvalidValue)(t, err)
+	assert.ErrorIs(t, err, getenv.ErrSyntax)
+
+	for _, base := range []int{-1, 1, 37} {
+		_, err = getenv.Env[[]int]("MASKS", option.WithSource(src), option.WithBase(base), option.WithSeparator(","))
+		errorEqual(getenv.ErrInvalidBase)(t, err)
+		assert.NotErrorIs(t, err, getenv.ErrInvalidValue)
+	}
+}
+
+func TestEnvRange(t *testing.T) {
+	src := getenv.MapSource{
+		"PORT":    "70000",
+		"TIMEOUT": "-5s",
+		"PEERS":   "80,443",
+	}
+
+	_, err := getenv.Env[int]("PORT", option.WithSource(src), option.WithRange(1, 65535))
+	errorEqual(getenv.ErrInvalidValue)(t, err)
+	assert.ErrorIs(t, err, getenv.ErrOutOfRange)
+	assert.ErrorContains(t, err, "70000 is greater than the maximum 65535")
+
+	_, err = getenv.Env[time.Duration]("TIMEOUT", option.WithSource(src), option.WithMin(time.Duration(0)))
+	assert.ErrorIs(t, err, getenv.ErrOutOfRange)
+
+	peers, err := getenv.Env[[]uint16]("PEERS",
+		option.WithSource(src), option.WithSeparator(","), option.WithMin(1), option.WithMax(1024))
+	require.NoError(t, err)
+	assert.Equal(t, []uint16{80, 443}, peers)
+}
+
+func TestEnvOneOf(t *testing.T) {
+	src := getenv.MapSource{
+		"LOG_FORMAT": "JSON",
+		"MODE":       "relase",
+		"PORT":       "8080",
+	}
+
+	format, err := getenv.Env[string]("LOG_FORMAT",
+		option.WithSource(src), option.WithOneOf("json", "text"), option.WithIgnoreCase())
+	require.NoError(t, err)
+	assert.Equal(t, "json", format)
+
+	_, err = getenv.Env[string]("MODE", option.WithSource(src), option.WithOneOf("debug", "release"))
+	errorEqual(getenv.ErrInvalidValue)(t, err)
+	assert.ErrorIs(t, err, getenv.ErrNotAllowed)
+	assert.ErrorContains(t, err, `"relase" is not one of "debug", "release", did you mean "release"?`)
+
+	_, err = getenv.Env[int]("PORT", option.WithSource(src), option.WithOneOf(80, 443))
+	assert.ErrorIs(t, err, getenv.ErrNotAllowed)
+}
+
+func TestEnvPattern(t *testing.T) {
+	src := getenv.MapSource{
+		"REGION":  "eu-west-1",
+		"REGIONS": "eu-west-1,US_EAST",
+	}
+
+	re := regexp.MustCompile(`^[a-z]{2}-[a-z]+-\d$`)
+
+	region, err := getenv.Env[string]("REGION", option.WithSource(src), option.WithPattern(re))
+	require.NoError(t, err)
+	assert.Equal(t, "eu-west-1", region)
+
+	_, err = getenv.Env[[]string]("REGIONS", option.WithSource(src), option.WithPattern(re), option.WithSeparator(","))
+	errorEqual(getenv.ErrInvalidValue)(t, err)
+	assert.ErrorContains(t, err, re.String())
+}
+
+func TestEnvEmptyElements(t *testing.T) {
+	src := getenv.MapSource{
+		"HOSTS": "a, b,,c ",
+		"PORTS": "80, 443,",
+	}
+
+	hosts, err := getenv.Env[[]string]("HOSTS", option.WithSource(src), option.WithSeparator(","))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", " b", "", "c "}, hosts)
+
+	hosts, err = getenv.Env[[]string]("HOSTS", option.WithSource(src), option.WithSeparator(","),
+		option.WithTrimSpace(), option.WithEmptyElements(option.EmptyDrop))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, hosts)
+
+	_, err = getenv.Env[[]string]("HOSTS", option.WithSource(src), option.WithSeparator(","),
+		option.WithEmptyElements(option.EmptyReject))
+	errorEqual(getenv.ErrEmptyElement)(t, err)
+
+	_, err = getenv.Env[[]int]("PORTS", option.WithSource(src), option.WithSeparator(","), option.WithTrimSpace())
+	errorEqual(getenv.ErrEmptyElement)(t, err)
+
+	ports, err := getenv.Env[[]int]("PORTS", option.WithSource(src), option.WithSeparator(","),
+		option.WithTrimSpace(), option.WithEmptyElements(option.EmptyDrop))
+	require.NoError(t, err)
+	assert.Equal(t, []int{80, 443}, ports)
+}
+
+func TestEnvTimeLayouts(t *testing.T) {
+	src := getenv.MapSource{
+		"DATE":     "2024-03-05",
+		"DATES":    "2024-03-05,2024-03-05T14:30:15Z",
+		"INVALID":  "05.03.2024",
+		"DEADLINE": "Tue, 05 Mar 2024 14:30:15 UTC",
+	}
+
+	date := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+	moment := time.Date(2024, time.March, 5, 14, 30, 15, 0, time.UTC)
+
+	got, err := getenv.Env[time.Time]("DATE", option.WithSource(src), option.WithTimeLayouts(time.RFC3339, time.DateOnly))
+	require.NoError(t, err)
+	assert.True(t, date.Equal(got))
+
+	dates, err := getenv.Env[[]time.Time]("DATES", option.WithSource(src), option.WithSeparator(","),
+		option.WithAutoTimeLayout())
+	require.NoError(t, err)
+	require.Len(t, dates, 2)
+	assert.True(t, date.Equal(dates[0]))
+	assert.True(t, moment.Equal(dates[1]))
+
+	deadline, err := getenv.Env[*time.Time]("DEADLINE", option.WithSource(src), option.WithAutoTimeLayout())
+	require.NoError(t, err)
+	require.NotNil(t, deadline)
+	assert.True(t, moment.Equal(*deadline))
+
+	_, err = getenv.Env[time.Time]("INVALID", option.WithSource(src), option.WithAutoTimeLayout())
+	require.ErrorIs(t, err, getenv.ErrSyntax)
+	require.ErrorIs(t, err, getenv.ErrInvalidValue)
+	assert.ErrorContains(t, err, `does not match any of the layouts "2006-01-02T15:04:05Z07:00"`)
+
+	_, err = getenv.Env[time.Time]("DATE", option.WithSource(src))
+	errorEqual(getenv.ErrMissingLayout)(t, err)
+}
+
+func TestEnvLocation(t *testing.T) {
+	src := getenv.MapSource{
+		"TZ":       "Europe/Berlin",
+		"INVALID":  "Mars/Olympus",
+		"SCHEDULE": "2024-03-05 14:30:15",
+	}
+
+	loc, err := getenv.Env[*time.Location]("TZ", option.WithSource(src))
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/Berlin", loc.String())
+
+	_, err = getenv.Env[*time.Location]("INVALID", option.WithSource(src))
+	require.ErrorIs(t, err, getenv.ErrSyntax)
+	require.ErrorIs(t, err, getenv.ErrInvalidValue)
+
+	unset, err := getenv.Env[*time.Location]("UNSET", option.WithSource(src))
+	require.NoError(t, err)
+	assert.Nil(t, unset)
+
+	var cfg struct {
+		TZ *time.Location `env:"UNSET,required"`
+	}
+
+	err = getenv.Bind(&cfg, option.WithSource(src))
+	require.ErrorIs(t, err, getenv.ErrNotSet)
+
+	schedule, err := getenv.Env[time.Time]("SCHEDULE", option.WithSource(src),
+		option.WithTimeLayout(time.DateTime), option.WithLocation(loc))
+	require.NoError(t, err)
+	assert.Equal(t, loc, schedule.Location())
+	assert.True(t, time.Date(2024, time.March, 5, 13, 30, 15, 0, time.UTC).Equal(schedule))
+
+	assert.Equal(t, time.UTC, getenv.EnvOrDefault("UNSET", time.UTC, option.WithSource(src)))
+}
@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// Bound is a constraint for the types of Parameters.Min and Parameters.Max.
+type Bound interface {
+	Number | time.Duration | ByteSize
+}
+
+// checkBounds reports the value that is less than Parameters.Min or greater than Parameters.Max
+// with ErrOutOfRange. Bounds that are not set are not checked. NaN is out of any range.
+func checkBounds(v any, p Parameters) error {
+	if (p.Min != nil || p.Max != nil) && isNaN(v) {
+		return newInvalidValueError(fmt.Errorf("%v is not comparable with the bounds", v), ErrOutOfRange)
+	}
+
+	if p.Min != nil && compareNumbers(v, p.Min) < 0 {
+		return newInvalidValueError(fmt.Errorf("%v is less than the minimum %v", v, p.Min), ErrOutOfRange)
+	}
+
+	if p.Max != nil && compareNumbers(v, p.Max) > 0 {
+		return newInvalidValueError(fmt.Errorf("%v is greater than the maximum %v", v, p.Max), ErrOutOfRange)
+	}
+
+	return nil
+}
+
+// compareNumbers compares numbers of any integer or float types, like cmp.Compare does.
+func compareNumbers(a, b any) int {
+	x, y := reflect.ValueOf(a), reflect.ValueOf(b)
+
+	switch {
+	case x.CanFloat() || y.CanFloat():
+		return cmp.Compare(toFloat(x), toFloat(y))
+	case x.CanInt() && y.CanInt():
+		return cmp.Compare(x.Int(), y.Int())
+	case x.CanUint() && y.CanUint():
+		return cmp.Compare(x.Uint(), y.Uint())
+	case x.CanInt():
+		// Negative integers are less than any unsigned integer.
+		if x.Int() < 0 {
+			return -1
+		}
+
+		return cmp.Compare(uint64(x.Int()), y.Uint())
+	default:
+		if y.Int() < 0 {
+			return 1
+		}
+
+		return cmp.Compare(x.Uint(), uint64(y.Int()))
+	}
+}
+
+// isNaN reports whether the value is a float NaN.
+func isNaN(v any) bool {
+	rv := reflect.ValueOf(v)
+
+	return rv.CanFloat() && math.IsNaN(rv.Float())
+}
+
+// toFloat converts the integer or float value to float64.
+func toFloat(v reflect.Value) float64 {
+	switch {
+	case v.CanFloat():
+		return v.Float()
+	case v.CanInt():
+		return float64(v.Int())
+	default:
+		return float64(v.Uint())
+	}
+}
@@ -0,0 +1,150 @@
+package internal
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_compareNumbers(t *testing.T) {
+	tests := []struct {
+		name string
+		a    any
+		b    any
+		want int
+	}{
+		{name: "ints", a: 1, b: int8(2), want: -1},
+		{name: "uints", a: uint64(math.MaxUint64), b: uint8(1), want: 1},
+		{name: "negative int and uint", a: -1, b: uint(0), want: -1},
+		{name: "uint and negative int", a: uint(0), b: -1, want: 1},
+		{name: "int and uint", a: 5, b: uint16(5), want: 0},
+		{name: "big uint and int", a: uint64(math.MaxUint64), b: math.MaxInt64, want: 1},
+		{name: "float and int", a: 1.5, b: 2, want: -1},
+		{name: "durations", a: -5 * time.Second, b: time.Duration(0), want: -1},
+		{name: "byte size and int", a: KiB, b: 1024, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, compareNumbers(tt.a, tt.b))
+		})
+	}
+}
+
+func Test_checkBounds(t *testing.T) {
+	src := mapSource{
+		"PORT":     "70000",
+		"PORTS":    "80,0,443",
+		"TIMEOUT":  "-5s",
+		"TIMEOUTS": "1s,2m",
+		"RATIO":    "0.5",
+		"NAN":      "NaN",
+		"SIZE":     "2GiB",
+	}
+
+	tests := []struct {
+		name    string
+		parse   func() (any, error)
+		want    any
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "int greater than maximum",
+			parse: func() (any, error) {
+				return getNumberGen[int]("PORT", Parameters{Source: src, Min: 1, Max: 65535})
+			},
+			want:    0,
+			wantErr: errorKind(t, ErrOutOfRange),
+		},
+		{
+			name: "int within range",
+			parse: func() (any, error) {
+				return getNumberGen[int]("PORT", Parameters{Source: src, Min: 1, Max: 100000})
+			},
+			want:    70000,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "slice element less than minimum",
+			parse: func() (any, error) {
+				return getNumberSliceGen[uint16]("PORTS", Parameters{Source: src, Separator: ",", Min: 1})
+			},
+			want: []uint16(nil),
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorIs(t, err, ErrOutOfRange, i...) &&
+					assert.ErrorContains(t, err, `element [1] "0"`, i...)
+			},
+		},
+		{
+			name: "duration less than minimum",
+			parse: func() (any, error) {
+				return getDuration("TIMEOUT", Parameters{Source: src, Min: time.Duration(0)})
+			},
+			want:    time.Duration(0),
+			wantErr: errorKind(t, ErrOutOfRange),
+		},
+		{
+			name: "duration slice greater than maximum",
+			parse: func() (any, error) {
+				return getDurationSlice("TIMEOUTS", Parameters{Source: src, Separator: ",", Max: time.Minute})
+			},
+			want:    []time.Duration(nil),
+			wantErr: errorKind(t, ErrOutOfRange),
+		},
+		{
+			name: "float within range",
+			parse: func() (any, error) {
+				return getNumberGen[float64]("RATIO", Parameters{Source: src, Min: 0, Max: 1})
+			},
+			want:    0.5,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "NaN with maximum",
+			parse: func() (any, error) {
+				return getNumberGen[float64]("NAN", Parameters{Source: src, Max: 10.0})
+			},
+			want:    0.0,
+			wantErr: errorKind(t, ErrOutOfRange),
+		},
+		{
+			name: "NaN with minimum",
+			parse: func() (any, error) {
+				return getNumberGen[float32]("NAN", Parameters{Source: src, Min: 0})
+			},
+			want:    float32(0),
+			wantErr: errorKind(t, ErrOutOfRange),
+		},
+		{
+			name: "NaN without bounds",
+			parse: func() (any, error) {
+				v, err := getNumberGen[float64]("NAN", Parameters{Source: src})
+
+				return math.IsNaN(v), err
+			},
+			want:    true,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "byte size greater than maximum",
+			parse: func() (any, error) {
+				return getByteSize("SIZE", Parameters{Source: src, Max: GiB})
+			},
+			want:    ByteSize(0),
+			wantErr: errorKind(t, ErrOutOfRange),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse()
+			if !tt.wantErr(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package internal
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ByteSize is a size in bytes, parsed from human-readable values with SI and IEC units, e.g. 512MiB or 1.5GB.
+type ByteSize uint64
+
+// Byte sizes in SI units.
+const (
+	B  ByteSize = 1
+	KB ByteSize = 1000 * B
+	MB ByteSize = 1000 * KB
+	GB ByteSize = 1000 * MB
+	TB ByteSize = 1000 * GB
+	PB ByteSize = 1000 * TB
+	EB ByteSize = 1000 * PB
+)
+
+// Byte sizes in IEC units.
+const (
+	KiB ByteSize = 1 << (10 * (iota + 1))
+	MiB
+	GiB
+	TiB
+	PiB
+	EiB
+)
+
+// byteSizeUnit is a unit of ByteSize.
+type byteSizeUnit struct {
+	name string
+	size ByteSize
+}
+
+// byteSizeUnits are the units of ByteSize from the largest to the smallest, IEC ones go first.
+var byteSizeUnits = []byteSizeUnit{
+	{name: "EiB", size: EiB},
+	{name: "PiB", size: PiB},
+	{name: "TiB", size: TiB},
+	{name: "GiB", size: GiB},
+	{name: "MiB", size: MiB},
+	{name: "KiB", size: KiB},
+	{name: "EB", size: EB},
+	{name: "PB", size: PB},
+	{name: "TB", size: TB},
+	{name: "GB", size: GB},
+	{name: "MB", size: MB},
+	{name: "KB", size: KB},
+	{name: "B", size: B},
+}
+
+// String formats the size with the largest unit that represents it exactly, e.g. 512MiB or 1500MB.
+// The shortest of the IEC and SI forms is returned, IEC is preferred when they are of the same length.
+func (b ByteSize) String() string {
+	if b == 0 {
+		return "0B"
+	}
+
+	var res string
+
+	for _, unit := range byteSizeUnits {
+		if b%unit.size != 0 {
+			continue
+		}
+
+		s := strconv.FormatUint(uint64(b/unit.size), decimalBase) + unit.name
+		if res == "" || len(s) < len(res) {
+			res = s
+		}
+	}
+
+	return res
+}
+
+// parseByteSize parses the size in bytes from a number with an optional unit, e.g. 1024, 64 MiB or 1.5GB.
+// Units are case-insensitive, fractional sizes are truncated to whole bytes.
+func parseByteSize(raw string) (ByteSize, error) {
+	s := strings.TrimSpace(raw)
+
+	idx := strings.IndexFunc(s, func(r rune) bool {
+		return !unicode.IsDigit(r) && r != '.'
+	})
+	if idx < 0 {
+		idx = len(s)
+	}
+
+	num, unitName := s[:idx], strings.TrimSpace(s[idx:])
+
+	unit, ok := lookupByteSizeUnit(unitName)
+	if !ok || num == "" || strings.Count(num, ".") > 1 || strings.HasPrefix(num, ".") || strings.HasSuffix(num, ".") {
+		return 0, wrapErrSyntax(fmt.Errorf("invalid byte size %q", raw))
+	}
+
+	r, ok := new(big.Rat).SetString(num)
+	if !ok {
+		return 0, wrapErrSyntax(fmt.Errorf("invalid byte size %q", raw))
+	}
+
+	r.Mul(r, new(big.Rat).SetUint64(uint64(unit)))
+
+	val := new(big.Int).Quo(r.Num(), r.Denom())
+	if !val.IsUint64() {
+		return 0, newInvalidValueError(fmt.Errorf("byte size %q overflows uint64", raw), ErrOutOfRange)
+	}
+
+	return ByteSize(val.Uint64()), nil
+}
+
+// lookupByteSizeUnit returns the unit by its case-insensitive name, bytes are used when the name is empty.
+func lookupByteSizeUnit(name string) (ByteSize, bool) {
+	if name == "" {
+		return B, true
+	}
+
+	for _, unit := range byteSizeUnits {
+		if strings.EqualFold(name, unit.name) {
+			return unit.size, true
+		}
+	}
+
+	return 0, false
+}
+
+func getByteSize(key string, p Parameters) (ByteSize, error) {
+	env, err := getString(key, p)
+	if err != nil {
+		return 0, err
+	}
+
+	return parseBoundedByteSize(env, p)
+}
+
+// parseBoundedByteSize parses the size in bytes and checks it against Parameters.Min and Parameters.Max.
+func parseBoundedByteSize(raw string, p Parameters) (ByteSize, error) {
+	val, err := parseByteSize(raw)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = checkBounds(val, p); err != nil {
+		return 0, err
+	}
+
+	if err = checkOneOfNumber(val, p); err != nil {
+		return 0, err
+	}
+
+	return val, nil
+}
+
+func getByteSizeSlice(key string, p Parameters) ([]ByteSize, error) {
+	env, err := getStringSlice(key, p)
+	if err != nil {
+		return nil, err
+	}
+
+	val := make([]ByteSize, 0, len(env))
+
+	for i, s := range env {
+		v, err := parseBoundedByteSize(s, p)
+		if err != nil {
+			return nil, newElementError(i, s, err)
+		}
+
+		val = append(val, v)
+	}
+
+	return val, nil
+}
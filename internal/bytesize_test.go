@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseByteSize(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    ByteSize
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{raw: "1024", want: 1024, wantErr: assert.NoError},
+		{raw: "0", want: 0, wantErr: assert.NoError},
+		{raw: "10B", want: 10, wantErr: assert.NoError},
+		{raw: "512MiB", want: 512 * MiB, wantErr: assert.NoError},
+		{raw: "64 MiB", want: 64 * MiB, wantErr: assert.NoError},
+		{raw: "1.5GB", want: 1_500_000_000, wantErr: assert.NoError},
+		{raw: "1.5GiB", want: 3 * GiB / 2, wantErr: assert.NoError},
+		{raw: "2kb", want: 2 * KB, wantErr: assert.NoError},
+		{raw: "1.1KiB", want: 1126, wantErr: assert.NoError},
+		{raw: "15EiB", want: 15 * EiB, wantErr: assert.NoError},
+		{raw: "16EiB", want: 0, wantErr: errorKind(t, ErrOutOfRange)},
+		{raw: "18446744073709551616", want: 0, wantErr: errorKind(t, ErrOutOfRange)},
+		{raw: "MiB", want: 0, wantErr: errorKind(t, ErrSyntax)},
+		{raw: "1.2.3MB", want: 0, wantErr: errorKind(t, ErrSyntax)},
+		{raw: ".5MB", want: 0, wantErr: errorKind(t, ErrSyntax)},
+		{raw: "-1MB", want: 0, wantErr: errorKind(t, ErrSyntax)},
+		{raw: "1XB", want: 0, wantErr: errorKind(t, ErrSyntax)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := parseByteSize(tt.raw)
+			if !tt.wantErr(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestByteSize_String(t *testing.T) {
+	tests := []struct {
+		size ByteSize
+		want string
+	}{
+		{size: 0, want: "0B"},
+		{size: 1, want: "1B"},
+		{size: 1023, want: "1023B"},
+		{size: KiB, want: "1KiB"},
+		{size: 512 * MiB, want: "512MiB"},
+		{size: 1_500_000_000, want: "1500MB"},
+		{size: 2 * GB, want: "2GB"},
+		{size: 3 * GiB / 2, want: "1536MiB"},
+		{size: 15 * EiB, want: "15EiB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.size.String())
+
+			got, err := parseByteSize(tt.want)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.size, got)
+		})
+	}
+}
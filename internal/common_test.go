@@ -84,3 +84,23 @@ func errorEqual(tb testing.TB, expected error) assert.ErrorAssertionFunc {
 			assert.ErrorContains(at, err, expected.Error(), i...)
 	}
 }
+
+// errorKind asserts that the error matches ErrInvalidValue of the kind, e.g. ErrSyntax.
+func errorKind(tb testing.TB, kind error) assert.ErrorAssertionFunc {
+	tb.Helper()
+
+	return func(at assert.TestingT, err error, i ...any) bool {
+		return assert.Error(at, err, i...) &&
+			assert.ErrorIs(at, err, kind, i...) &&
+			assert.ErrorIs(at, err, ErrInvalidValue, i...)
+	}
+}
+
+// mapSource is a Source backed by a map for tests.
+type mapSource map[string]string
+
+func (m mapSource) Lookup(key string) (string, bool) {
+	v, ok := m[key]
+
+	return v, ok
+}
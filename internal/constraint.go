@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"encoding"
 	"net"
 	"net/netip"
 	"net/url"
@@ -10,7 +11,7 @@
 type (
 	// EnvParsable is a constraint for types that can be parsed from environment variable.
 	EnvParsable interface {
-		String | Number | NumberSlice | Time | Bool | URL | Network | Complex | ComplexSlice
+		String | Number | NumberSlice | Time | Bool | URL | Network | Complex | ComplexSlice | Size | Pointer
 	}
 
 	// String is a constraint for string and slice of strings.
@@ -58,9 +59,9 @@
 		[]float32 | []float64
 	}
 
-	// Time is a constraint for time.Time and time.Duration and slices of them.
+	// Time is a constraint for time.Time and time.Duration and slices of them, and *time.Location.
 	Time interface {
-		time.Time | []time.Time | time.Duration | []time.Duration
+		time.Time | []time.Time | time.Duration | []time.Duration | *time.Location
 	}
 
 	// Bool is a constraint for bool and slice of bool.
@@ -90,4 +91,36 @@
 	Complex interface {
 		complex64 | complex128
 	}
+
+	// Size is a constraint for ByteSize and slice of ByteSize.
+	Size interface {
+		ByteSize | []ByteSize
+	}
+
+	// Pointer is a constraint for pointers to the scalar types and slices of them.
+	// Nil pointer stands for the variable that is not set.
+	// Maps and *[]ByteSize are not listed to keep the union within the compiler limit of 100 terms,
+	// they are supported by the reflection based parsers, see LookupEnvParser.
+	Pointer interface {
+		*string | *[]string |
+			*int | *[]int | *int8 | *[]int8 | *int16 | *[]int16 | *int32 | *[]int32 | *int64 | *[]int64 |
+			*uint | *[]uint | *uint8 | *[]uint8 | *uint16 | *[]uint16 | *uint32 | *[]uint32 | *uint64 | *[]uint64 |
+			*uintptr | *[]uintptr |
+			*float32 | *[]float32 | *float64 | *[]float64 |
+			*time.Time | *[]time.Time | *time.Duration | *[]time.Duration |
+			*bool | *[]bool |
+			*url.URL | *[]url.URL |
+			*net.IP | *[]net.IP |
+			*net.HardwareAddr | *[]net.HardwareAddr |
+			*netip.Addr | *[]netip.Addr |
+			*netip.Prefix | *[]netip.Prefix |
+			*complex64 | *[]complex64 | *complex128 | *[]complex128 |
+			*ByteSize
+	}
+
+	// TextUnmarshaler is a constraint for types whose pointer implements encoding.TextUnmarshaler.
+	TextUnmarshaler[T any] interface {
+		*T
+		encoding.TextUnmarshaler
+	}
 )
@@ -3,6 +3,7 @@
 import (
 	"errors"
 	"fmt"
+	"strconv"
 )
 
 var (
@@ -10,6 +11,22 @@
 	ErrNotSet = errors.New("not set")
 	// ErrInvalidValue is an error that is returned when the environment variable is not valid.
 	ErrInvalidValue = errors.New("invalid value")
+	// ErrOutOfRange is an error that is returned when the value is out of range of the type, it comes with ErrInvalidValue.
+	ErrOutOfRange = errors.New("out of range")
+	// ErrSyntax is an error that is returned when the value has invalid syntax for the type, it comes with ErrInvalidValue.
+	ErrSyntax = errors.New("invalid syntax")
+	// ErrEmptyElement is an error that is returned when the element of the slice or the map is empty,
+	// it comes with ErrInvalidValue.
+	ErrEmptyElement = errors.New("empty element")
+	// ErrNotAllowed is an error that is returned when the value is not one of the allowed values,
+	// it comes with ErrInvalidValue.
+	ErrNotAllowed = errors.New("not allowed")
+	// ErrMissingSeparator is an error that is returned when the separator required by the type is not set.
+	ErrMissingSeparator = errors.New("missing separator")
+	// ErrMissingLayout is an error that is returned when the time layout is not set.
+	ErrMissingLayout = errors.New("missing time layout")
+	// ErrInvalidBase is an error that is returned when the integer base is not from 2 to 36.
+	ErrInvalidBase = errors.New("invalid base")
 )
 
 func newErrInvalidValue(msg string) error {
@@ -23,3 +40,107 @@ func newErrNotSet(msg string) error {
 func newWrapErr(msg string, wrapErr error) error {
 	return fmt.Errorf("%s: %w", msg, wrapErr)
 }
+
+// wrapErrInvalidValue wraps the error so that it matches both the error and ErrInvalidValue.
+// strconv errors also match ErrSyntax or ErrOutOfRange.
+func wrapErrInvalidValue(err error) error {
+	if errors.Is(err, ErrInvalidValue) {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, strconv.ErrRange):
+		return newInvalidValueError(err, ErrOutOfRange)
+	case errors.Is(err, strconv.ErrSyntax):
+		return newInvalidValueError(err, ErrSyntax)
+	default:
+		return fmt.Errorf("%w: %w", err, ErrInvalidValue)
+	}
+}
+
+// wrapErrSyntax wraps the error so that it matches the error, ErrSyntax and ErrInvalidValue.
+func wrapErrSyntax(err error) error {
+	return newInvalidValueError(err, ErrSyntax)
+}
+
+// newErrEmptyElement reports the empty element of the slice or the map.
+func newErrEmptyElement(msg string) error {
+	return newInvalidValueError(errors.New(msg), ErrEmptyElement)
+}
+
+// newErrMissingSeparator reports the separator that is not set.
+func newErrMissingSeparator(name string) error {
+	return fmt.Errorf("%s is not set: %w", name, ErrMissingSeparator)
+}
+
+// invalidValueError is ErrInvalidValue of the specific kind, e.g. ErrSyntax.
+// Its message is the same as of the errors wrapped with wrapErrInvalidValue.
+type invalidValueError struct {
+	err  error
+	kind error
+}
+
+func newInvalidValueError(err, kind error) error {
+	return invalidValueError{
+		err:  err,
+		kind: kind,
+	}
+}
+
+func (e invalidValueError) Error() string {
+	return e.err.Error() + ": " + ErrInvalidValue.Error()
+}
+
+func (e invalidValueError) Unwrap() []error {
+	return []error{e.err, e.kind, ErrInvalidValue}
+}
+
+// ElementError is an error of parsing the element of the slice or the pair of the map.
+// Index is the index of the element, Raw is its raw value.
+// For two-level slices, Index is the index of the row and Column is the index of the element in the row,
+// otherwise Column is -1.
+type ElementError struct {
+	Index  int
+	Column int
+	Raw    string
+	Err    error
+}
+
+func (e *ElementError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+// newElementError reports invalid element of the slice at the index.
+// Empty elements are reported with ErrEmptyElement unless they fail the validation.
+func newElementError(index int, raw string, err error) error {
+	return &ElementError{
+		Index:  index,
+		Column: -1,
+		Raw:    raw,
+		Err:    fmt.Errorf("element [%d] %q: %w", index, raw, elementCause(raw, err)),
+	}
+}
+
+// newNestedElementError reports invalid element of the two-level slice at the row and the column.
+// Empty elements are reported with ErrEmptyElement unless they fail the validation.
+func newNestedElementError(row, column int, raw string, err error) error {
+	return &ElementError{
+		Index:  row,
+		Column: column,
+		Raw:    raw,
+		Err:    fmt.Errorf("element [%d][%d] %q: %w", row, column, raw, elementCause(raw, err)),
+	}
+}
+
+// elementCause returns the cause of the element error matching ErrInvalidValue.
+func elementCause(raw string, err error) error {
+	if raw == "" && !isValidationError(err) {
+		err = newErrEmptyElement("empty element")
+	}
+
+	return wrapErrInvalidValue(err)
+}
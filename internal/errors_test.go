@@ -0,0 +1,136 @@
+package internal
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestElementError(t *testing.T) {
+	src := mapSource{
+		"INTS": "1,x,3",
+	}
+
+	params := Parameters{
+		Source:    src,
+		Separator: ",",
+	}
+
+	_, err := getNumberSliceGen[int]("INTS", params)
+	errorEqual(t, ErrInvalidValue)(t, err)
+	assert.ErrorContains(t, err, `element [1] "x"`)
+	assert.True(t, errors.Is(err, strconv.ErrSyntax))
+
+	var elemErr *ElementError
+
+	require.ErrorAs(t, err, &elemErr)
+	assert.Equal(t, 1, elemErr.Index)
+	assert.Equal(t, -1, elemErr.Column)
+	assert.Equal(t, "x", elemErr.Raw)
+
+	_, err = getStringSlice("INTS", params)
+	require.NoError(t, err)
+}
+
+func TestRawValue(t *testing.T) {
+	params := Parameters{
+		Source: mapSource{
+			"KEY": "value",
+		},
+	}
+
+	raw, ok := RawValue("KEY", params)
+	assert.True(t, ok)
+	assert.Equal(t, "value", raw)
+
+	_, ok = RawValue("MISSING", params)
+	assert.False(t, ok)
+}
+
+func TestErrorKinds(t *testing.T) {
+	src := mapSource{
+		"RANGE":  "300",
+		"SYNTAX": "3OO",
+		"IP":     "localhost",
+		"EMPTY":  "1,,3",
+		"TIME":   "2020-01-01",
+	}
+
+	tests := []struct {
+		name  string
+		parse func() error
+		kinds []error
+	}{
+		{
+			name: "out of range",
+			parse: func() error {
+				_, err := getNumberGen[uint8]("RANGE", Parameters{Source: src})
+
+				return err
+			},
+			kinds: []error{ErrOutOfRange, ErrInvalidValue, strconv.ErrRange},
+		},
+		{
+			name: "syntax",
+			parse: func() error {
+				_, err := getNumberGen[uint8]("SYNTAX", Parameters{Source: src})
+
+				return err
+			},
+			kinds: []error{ErrSyntax, ErrInvalidValue, strconv.ErrSyntax},
+		},
+		{
+			name: "ip syntax",
+			parse: func() error {
+				_, err := getIP("IP", Parameters{Source: src})
+
+				return err
+			},
+			kinds: []error{ErrSyntax, ErrInvalidValue},
+		},
+		{
+			name: "empty element",
+			parse: func() error {
+				_, err := getNumberSliceGen[int]("EMPTY", Parameters{Source: src, Separator: ","})
+
+				return err
+			},
+			kinds: []error{ErrEmptyElement, ErrInvalidValue},
+		},
+		{
+			name: "missing separator",
+			parse: func() error {
+				_, err := getNumberSliceGen[int]("EMPTY", Parameters{Source: src})
+
+				return err
+			},
+			kinds: []error{ErrMissingSeparator},
+		},
+		{
+			name: "missing layout",
+			parse: func() error {
+				_, err := getTime("TIME", Parameters{Source: src})
+
+				return err
+			},
+			kinds: []error{ErrMissingLayout},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.parse()
+			require.Error(t, err)
+
+			for _, kind := range tt.kinds {
+				assert.ErrorIs(t, err, kind)
+			}
+		})
+	}
+
+	_, err := getNumberSliceGen[int]("EMPTY", Parameters{Source: src})
+	assert.NotErrorIs(t, err, ErrInvalidValue)
+}
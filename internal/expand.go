@@ -0,0 +1,153 @@
+package internal
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Operators of the variable reference.
+const (
+	// expandOpDefault substitutes the default when the referenced variable is not set: ${NAME:-default}.
+	expandOpDefault = ":-"
+	// expandOpRequired fails with the message when the referenced variable is not set: ${NAME:?message}.
+	expandOpRequired = ":?"
+)
+
+// expandValue expands variable references in the raw value of the key.
+//
+// Supported forms:
+//   - ${NAME} is replaced with the value of NAME, or an empty string when NAME is not set;
+//   - ${NAME:-default} is replaced with default when NAME is not set;
+//   - ${NAME:?message} fails with the message when NAME is not set;
+//   - $$ is replaced with a single $.
+//
+// Referenced values are expanded recursively, reference cycles are reported as errors.
+func expandValue(key, raw string, p Parameters) (string, error) {
+	e := expander{
+		params: p,
+		stack:  []string{key},
+	}
+
+	return e.expand(raw)
+}
+
+// expander keeps the chain of variables being expanded to detect reference cycles.
+type expander struct {
+	params Parameters
+	stack  []string
+}
+
+func (e *expander) expand(s string) (string, error) {
+	var b strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' || i+1 == len(s) {
+			b.WriteByte(s[i])
+
+			continue
+		}
+
+		switch s[i+1] {
+		case '$':
+			b.WriteByte('$')
+			i++
+		case '{':
+			end := closingBrace(s, i+2)
+			if end < 0 {
+				return "", newErrInvalidValue(fmt.Sprintf("unterminated variable reference %q", s[i:]))
+			}
+
+			val, err := e.resolve(s[i+2 : end])
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString(val)
+
+			i = end
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return b.String(), nil
+}
+
+// resolve returns the value of the reference expression without the enclosing ${ and }.
+func (e *expander) resolve(expr string) (string, error) {
+	name, op, arg := expr, "", ""
+
+	opLen := len(expandOpDefault)
+
+	if idx := strings.Index(expr, ":"); idx >= 0 && len(expr) >= idx+opLen {
+		name, op, arg = expr[:idx], expr[idx:idx+opLen], expr[idx+opLen:]
+	}
+
+	if name == "" || strings.ContainsAny(name, "${}:") || (op != "" && op != expandOpDefault && op != expandOpRequired) {
+		return "", newErrInvalidValue(fmt.Sprintf("invalid variable reference \"${%s}\"", expr))
+	}
+
+	val, err := e.lookup(name)
+	if err != nil {
+		return "", err
+	}
+
+	if val != "" {
+		return val, nil
+	}
+
+	switch op {
+	case expandOpDefault:
+		return e.expand(arg)
+	case expandOpRequired:
+		if arg == "" {
+			arg = ErrNotSet.Error()
+		}
+
+		return "", newErrInvalidValue(fmt.Sprintf("%s: %s", name, arg))
+	default:
+		return "", nil
+	}
+}
+
+// lookup returns the expanded value of the referenced variable.
+func (e *expander) lookup(name string) (string, error) {
+	if slices.Contains(e.stack, name) {
+		chain := append(slices.Clone(e.stack), name)
+
+		return "", newErrInvalidValue(fmt.Sprintf("variable reference cycle: %s", strings.Join(chain, " -> ")))
+	}
+
+	raw, ok := e.params.lookup(name)
+	if !ok || raw == "" {
+		return "", nil
+	}
+
+	e.stack = append(e.stack, name)
+	defer func() {
+		e.stack = e.stack[:len(e.stack)-1]
+	}()
+
+	return e.expand(raw)
+}
+
+// closingBrace returns the index of the brace closing the reference started before start, or -1.
+func closingBrace(s string, start int) int {
+	depth := 1
+
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,181 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_expandValue(t *testing.T) {
+	src := mapSource{
+		"HOST":    "example.com",
+		"PORT":    "8443",
+		"URL":     "https://${HOST}:${PORT}",
+		"API":     "${URL}/api",
+		"EMPTY":   "",
+		"CYCLE_A": "${CYCLE_B}",
+		"CYCLE_B": "x${CYCLE_A}",
+		"SELF":    "${SELF}",
+	}
+
+	type expected struct {
+		val     string
+		wantErr assert.ErrorAssertionFunc
+	}
+
+	tests := []struct {
+		name     string
+		key      string
+		raw      string
+		expected expected
+	}{
+		{
+			name: "no references",
+			raw:  "plain $ value $HOME",
+			expected: expected{
+				val:     "plain $ value $HOME",
+				wantErr: assert.NoError,
+			},
+		},
+		{
+			name: "references",
+			raw:  "https://${HOST}:${PORT}/path",
+			expected: expected{
+				val:     "https://example.com:8443/path",
+				wantErr: assert.NoError,
+			},
+		},
+		{
+			name: "recursive references",
+			raw:  "${API}/v1",
+			expected: expected{
+				val:     "https://example.com:8443/api/v1",
+				wantErr: assert.NoError,
+			},
+		},
+		{
+			name: "not set reference",
+			raw:  "a${MISSING}b",
+			expected: expected{
+				val:     "ab",
+				wantErr: assert.NoError,
+			},
+		},
+		{
+			name: "default",
+			raw:  "${MISSING:-${EMPTY:-fallback}}:${PORT:-80}",
+			expected: expected{
+				val:     "fallback:8443",
+				wantErr: assert.NoError,
+			},
+		},
+		{
+			name: "escape",
+			raw:  "$${HOST} costs $$5",
+			expected: expected{
+				val:     "${HOST} costs $5",
+				wantErr: assert.NoError,
+			},
+		},
+		{
+			name: "required set",
+			raw:  "${HOST:?host is required}",
+			expected: expected{
+				val:     "example.com",
+				wantErr: assert.NoError,
+			},
+		},
+		{
+			name: "required not set",
+			raw:  "${MISSING:?must be configured}",
+			expected: expected{
+				wantErr: expandErr("MISSING: must be configured"),
+			},
+		},
+		{
+			name: "required not set, no message",
+			raw:  "${EMPTY:?}",
+			expected: expected{
+				wantErr: expandErr("EMPTY: not set"),
+			},
+		},
+		{
+			name: "cycle",
+			key:  "START",
+			raw:  "${CYCLE_A}",
+			expected: expected{
+				wantErr: expandErr("variable reference cycle: START -> CYCLE_A -> CYCLE_B -> CYCLE_A"),
+			},
+		},
+		{
+			name: "self reference",
+			key:  "SELF",
+			raw:  "${SELF}",
+			expected: expected{
+				wantErr: expandErr("variable reference cycle: SELF -> SELF"),
+			},
+		},
+		{
+			name: "unterminated",
+			raw:  "${HOST",
+			expected: expected{
+				wantErr: expandErr(`unterminated variable reference "${HOST"`),
+			},
+		},
+		{
+			name: "invalid operator",
+			raw:  "${HOST:+alt}",
+			expected: expected{
+				wantErr: expandErr(`invalid variable reference "${HOST:+alt}"`),
+			},
+		},
+		{
+			name: "empty name",
+			raw:  "${}",
+			expected: expected{
+				wantErr: expandErr(`invalid variable reference "${}"`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := tt.key
+			if key == "" {
+				key = testEnvKey
+			}
+
+			got, err := expandValue(key, tt.raw, Parameters{Source: src})
+			if !tt.expected.wantErr(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.expected.val, got)
+		})
+	}
+}
+
+func Test_getStringExpand(t *testing.T) {
+	src := mapSource{
+		testEnvKey:  "${MISSING}",
+		"PORT":      "${PORT_BASE}0",
+		"PORT_BASE": "808",
+	}
+
+	_, err := getString(testEnvKey, Parameters{Source: src, Expand: true})
+	errorEqual(t, ErrNotSet)(t, err)
+
+	got, err := getNumberGen[int]("PORT", Parameters{Source: src, Expand: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 8080, got)
+
+	_, err = getNumberGen[int]("PORT", Parameters{Source: src})
+	errorEqual(t, ErrInvalidValue)(t, err)
+}
+
+func expandErr(msg string) assert.ErrorAssertionFunc {
+	return func(t assert.TestingT, err error, i ...any) bool {
+		return assert.ErrorIs(t, err, ErrInvalidValue, i...) &&
+			assert.ErrorContains(t, err, msg, i...)
+	}
+}
@@ -2,15 +2,60 @@
 package internal
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"net/netip"
 	"net/url"
+	"reflect"
 	"time"
 )
 
 // NewEnvParser is a constructor for EnvParser.
+// It panics if the type of v is not supported.
 func NewEnvParser(v any) EnvParser {
+	p, ok := LookupEnvParser(v)
+	if !ok {
+		panic(fmt.Sprintf("unsupported type :%T", v))
+	}
+
+	return p
+}
+
+// LookupEnvParser returns EnvParser for the type of v.
+// Built-in types are looked up first, then the types registered with RegisterParser,
+// then the types whose pointer implements encoding.TextUnmarshaler, and slices of them.
+// Maps with keys and values of these types, two-level slices of them and pointers to any of these types
+// are supported as well.
+// The boolean result reports whether the type is supported.
+func LookupEnvParser(v any) (EnvParser, bool) {
+	if p := newBuiltinParser(v); p != nil {
+		return p, true
+	}
+
+	t := reflect.TypeOf(v)
+
+	if p, ok := lookupCustomParser(t); ok {
+		return p, true
+	}
+
+	if p, ok := lookupTextParser(t); ok {
+		return p, true
+	}
+
+	if p, ok := lookupMapParser(t); ok {
+		return p, true
+	}
+
+	if p, ok := lookupNestedSliceParser(t); ok {
+		return p, true
+	}
+
+	return lookupPointerParser(t)
+}
+
+// newBuiltinParser returns EnvParser for the built-in supported types or nil.
+func newBuiltinParser(v any) EnvParser {
 	var p EnvParser
 
 	switch t := v.(type) {
@@ -24,7 +69,7 @@ func NewEnvParser(v any) EnvParser {
 		p = newBoolParser(t)
 	case float32, []float32, float64, []float64:
 		p = newFloatParser(t)
-	case time.Time, []time.Time, time.Duration, []time.Duration:
+	case time.Time, []time.Time, time.Duration, []time.Duration, *time.Location:
 		p = newTimeParser(t)
 	case url.URL, []url.URL:
 		p = newURLParser(t)
@@ -36,14 +81,12 @@ func NewEnvParser(v any) EnvParser {
 		p = newHardwareAddrParser(t)
 	case complex64, []complex64, complex128, []complex128:
 		p = newComplexParser(t)
+	case ByteSize, []ByteSize:
+		p = newByteSizeParser(t)
 	default:
 		p = nil
 	}
 
-	if p == nil {
-		panic(fmt.Sprintf("unsupported type :%T", v))
-	}
-
 	return p
 }
 
@@ -63,6 +106,18 @@ func newComplexParser(v any) EnvParser {
 	}
 }
 
+// newByteSizeParser is a constructor for ByteSize parsers.
+func newByteSizeParser(v any) EnvParser {
+	switch t := v.(type) {
+	case ByteSize:
+		return byteSizeParser(t)
+	case []ByteSize:
+		return byteSizeSliceParser(t)
+	default:
+		return nil
+	}
+}
+
 // newURLParser is a constructor for url.URL parsers.
 func newURLParser(v any) EnvParser {
 	switch t := v.(type) {
@@ -213,6 +268,8 @@ func newTimeParser(v any) EnvParser {
 		return durationParser(t)
 	case []time.Duration:
 		return durationSliceParser(t)
+	case *time.Location:
+		return locationParser{}
 	default:
 		return nil
 	}
@@ -239,168 +296,170 @@ type EnvParser interface {
 // stringParser is a parser for string type.
 type stringParser string
 
-func (s stringParser) ParseEnv(key string, _ Parameters) (any, error) {
-	return getString(key)
+func (s stringParser) ParseEnv(key string, options Parameters) (any, error) {
+	return getStringValue(key, options)
 }
 
 type stringSliceParser []string
 
 func (s stringSliceParser) ParseEnv(key string, options Parameters) (any, error) {
-	sep := options.Separator
-
-	return getStringSlice(key, sep)
+	return getStringValueSlice(key, options)
 }
 
 type numberParser[T Number] struct{}
 
-func (n numberParser[T]) ParseEnv(key string, _ Parameters) (any, error) {
-	return getNumberGen[T](key)
+func (n numberParser[T]) ParseEnv(key string, options Parameters) (any, error) {
+	return getNumberGen[T](key, options)
 }
 
 type numberSliceParser[T Number] struct{}
 
 func (i numberSliceParser[T]) ParseEnv(key string, options Parameters) (any, error) {
-	sep := options.Separator
-
-	return getNumberSliceGen[T](key, sep)
+	return getNumberSliceGen[T](key, options)
 }
 
 type boolParser bool
 
-func (b boolParser) ParseEnv(key string, _ Parameters) (any, error) {
-	return getBool(key)
+func (b boolParser) ParseEnv(key string, options Parameters) (any, error) {
+	return getBool(key, options)
 }
 
 type timeParser time.Time
 
 func (t timeParser) ParseEnv(key string, options Parameters) (any, error) {
-	layout := options.Layout
-
-	return getTime(key, layout)
+	return getTime(key, options)
 }
 
 type timeSliceParser []time.Time
 
 func (t timeSliceParser) ParseEnv(key string, options Parameters) (any, error) {
-	layout := options.Layout
-	sep := options.Separator
-
-	return getTimeSlice(key, layout, sep)
+	return getTimeSlice(key, options)
 }
 
 type durationSliceParser []time.Duration
 
 func (t durationSliceParser) ParseEnv(key string, options Parameters) (any, error) {
-	sep := options.Separator
-
-	return getDurationSlice(key, sep)
+	return getDurationSlice(key, options)
 }
 
 type durationParser time.Duration
 
-func (d durationParser) ParseEnv(key string, _ Parameters) (any, error) {
-	return getDuration(key)
+func (d durationParser) ParseEnv(key string, options Parameters) (any, error) {
+	return getDuration(key, options)
 }
 
 // stringSliceParser is a parser for []string
 type urlParser url.URL
 
-func (t urlParser) ParseEnv(key string, _ Parameters) (any, error) {
-	return getURL(key)
+func (t urlParser) ParseEnv(key string, options Parameters) (any, error) {
+	return getURL(key, options)
 }
 
 // urlSliceParser is a parser for []url.URL
 type urlSliceParser []url.URL
 
 func (t urlSliceParser) ParseEnv(key string, opts Parameters) (any, error) {
-	separator := opts.Separator
-
-	return getURLSlice(key, separator)
+	return getURLSlice(key, opts)
 }
 
 // ipParser is a parser for net.IP
 type ipParser net.IP
 
-func (t ipParser) ParseEnv(key string, _ Parameters) (any, error) {
-	return getIP(key)
+func (t ipParser) ParseEnv(key string, options Parameters) (any, error) {
+	return getIP(key, options)
 }
 
 // ipSliceParser is a parser for []net.IP
 type ipSliceParser []net.IP
 
 func (t ipSliceParser) ParseEnv(key string, opts Parameters) (any, error) {
-	separator := opts.Separator
-
-	return getIPSlice(key, separator)
+	return getIPSlice(key, opts)
 }
 
 // netIPAddrParser is a parser for netip.Addr.
 type netIPAddrParser netip.Addr
 
-func (t netIPAddrParser) ParseEnv(key string, _ Parameters) (any, error) {
-	return getNetIPAddr(key)
+func (t netIPAddrParser) ParseEnv(key string, options Parameters) (any, error) {
+	return getNetIPAddr(key, options)
 }
 
 // netIPAddrSliceParser is a parser for []netip.Addr.
 type netIPAddrSliceParser []netip.Addr
 
 func (t netIPAddrSliceParser) ParseEnv(key string, opts Parameters) (any, error) {
-	separator := opts.Separator
-
-	return getNetIPAddrSlice(key, separator)
+	return getNetIPAddrSlice(key, opts)
 }
 
 // netIPPrefixParser is a parser for netip.Prefix.
 type netIPPrefixParser netip.Prefix
 
-func (t netIPPrefixParser) ParseEnv(key string, _ Parameters) (any, error) {
-	return getNetIPPrefix(key)
+func (t netIPPrefixParser) ParseEnv(key string, options Parameters) (any, error) {
+	return getNetIPPrefix(key, options)
 }
 
 // netIPPrefixSliceParser is a parser for []netip.Prefix.
 type netIPPrefixSliceParser []netip.Prefix
 
 func (t netIPPrefixSliceParser) ParseEnv(key string, opts Parameters) (any, error) {
-	separator := opts.Separator
-
-	return getNetIPPrefixSlice(key, separator)
+	return getNetIPPrefixSlice(key, opts)
 }
 
 // hardwareAddrParser is a parser for net.HardwareAddr.
 type hardwareAddrParser net.HardwareAddr
 
-func (t hardwareAddrParser) ParseEnv(key string, _ Parameters) (any, error) {
-	return getHardwareAddr(key)
+func (t hardwareAddrParser) ParseEnv(key string, options Parameters) (any, error) {
+	return getHardwareAddr(key, options)
 }
 
 // hardwareAddrSliceParser is a parser for []net.HardwareAddr.
 type hardwareAddrSliceParser []net.HardwareAddr
 
 func (t hardwareAddrSliceParser) ParseEnv(key string, opts Parameters) (any, error) {
-	separator := opts.Separator
-
-	return getHardwareAddrSlice(key, separator)
+	return getHardwareAddrSlice(key, opts)
 }
 
 // boolSliceParser is a parser for []bool
 type boolSliceParser []bool
 
 func (b boolSliceParser) ParseEnv(key string, options Parameters) (any, error) {
-	sep := options.Separator
-
-	return getBoolSlice(key, sep)
+	return getBoolSlice(key, options)
 }
 
 type complexParser[T Complex] struct{}
 
-func (n complexParser[T]) ParseEnv(key string, _ Parameters) (any, error) {
-	return getComplexGen[T](key)
+func (n complexParser[T]) ParseEnv(key string, options Parameters) (any, error) {
+	return getComplexGen[T](key, options)
 }
 
 type complexSliceParser[T Complex] struct{}
 
 func (i complexSliceParser[T]) ParseEnv(key string, options Parameters) (any, error) {
-	sep := options.Separator
+	return getComplexSliceGen[T](key, options)
+}
+
+// locationParser is a parser for *time.Location.
+// Like the other pointer types, it returns nil pointer for the variable that is not set.
+type locationParser struct{}
+
+func (l locationParser) ParseEnv(key string, options Parameters) (any, error) {
+	val, err := getLocation(key, options)
+	if errors.Is(err, ErrNotSet) {
+		return (*time.Location)(nil), nil
+	}
+
+	return val, err
+}
+
+// byteSizeParser is a parser for ByteSize.
+type byteSizeParser ByteSize
+
+func (b byteSizeParser) ParseEnv(key string, options Parameters) (any, error) {
+	return getByteSize(key, options)
+}
+
+// byteSizeSliceParser is a parser for []ByteSize.
+type byteSizeSliceParser []ByteSize
 
-	return getComplexSliceGen[T](key, sep)
+func (b byteSizeSliceParser) ParseEnv(key string, options Parameters) (any, error) {
+	return getByteSizeSlice(key, options)
 }
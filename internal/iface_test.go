@@ -5,6 +5,7 @@
 	"net"
 	"net/netip"
 	"net/url"
+	"reflect"
 	"testing"
 	"time"
 
@@ -172,11 +173,16 @@ type input struct {
 		},
 		{
 			v:         (*string)(nil),
-			wantPanic: assert.Panics,
-			want:      nil,
+			wantPanic: assert.NotPanics,
+			want:      pointerParser{},
 		},
 		{
 			v:         (*int)(nil),
+			wantPanic: assert.NotPanics,
+			want:      pointerParser{},
+		},
+		{
+			v:         (**int)(nil),
 			wantPanic: assert.Panics,
 			want:      nil,
 		},
@@ -280,6 +286,21 @@ type input struct {
 			wantPanic: assert.NotPanics,
 			want:      complexSliceParser[complex128]{},
 		},
+		{
+			v:         ByteSize(1),
+			wantPanic: assert.NotPanics,
+			want:      byteSizeParser(0),
+		},
+		{
+			v:         []ByteSize{1},
+			wantPanic: assert.NotPanics,
+			want:      byteSizeSliceParser(nil),
+		},
+		{
+			v:         time.UTC,
+			wantPanic: assert.NotPanics,
+			want:      locationParser{},
+		},
 	}
 
 	for _, tt := range tests {
@@ -291,6 +312,58 @@ type input struct {
 	}
 }
 
+// TestLookupEnvParser tests the LookupEnvParser function.
+func TestLookupEnvParser(t *testing.T) {
+	tests := []struct {
+		name   string
+		v      any
+		want   EnvParser
+		wantOK bool
+	}{
+		{
+			name:   "supported",
+			v:      []int{0},
+			want:   numberSliceParser[int]{},
+			wantOK: true,
+		},
+		{
+			name: "pointer",
+			v:    (*[]int)(nil),
+			want: pointerParser{
+				elem:  reflect.TypeFor[[]int](),
+				inner: numberSliceParser[int]{},
+			},
+			wantOK: true,
+		},
+		{
+			name:   "pointer to not supported",
+			v:      (*notsupported)(nil),
+			want:   nil,
+			wantOK: false,
+		},
+		{
+			name:   "not supported",
+			v:      notsupported{},
+			want:   nil,
+			wantOK: false,
+		},
+		{
+			name:   "nil",
+			v:      nil,
+			want:   nil,
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := LookupEnvParser(tt.v)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 // Implement tests for newTimeParser function.
 func Test_newTimeParser(t *testing.T) {
 	type args struct {
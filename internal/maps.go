@@ -0,0 +1,191 @@
+package internal
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// lookupMapParser returns EnvParser for map whose key and value types are supported scalar types.
+func lookupMapParser(t reflect.Type) (EnvParser, bool) {
+	if t == nil || t.Kind() != reflect.Map {
+		return nil, false
+	}
+
+	key, ok := lookupScalarParser(t.Key())
+	if !ok {
+		return nil, false
+	}
+
+	val, ok := lookupScalarParser(t.Elem())
+	if !ok {
+		return nil, false
+	}
+
+	return mapParser{
+		typ: t,
+		key: key,
+		val: val,
+	}, true
+}
+
+// lookupScalarParser returns EnvParser for the supported type that is parsed from a single value,
+// slices, maps and pointers are not scalar.
+func lookupScalarParser(t reflect.Type) (EnvParser, bool) {
+	switch t.Kind() {
+	case reflect.Map, reflect.Pointer:
+		return nil, false
+	default:
+	}
+
+	p, ok := LookupEnvParser(reflect.Zero(t).Interface())
+	if !ok {
+		return nil, false
+	}
+
+	if t.Kind() != reflect.Slice {
+		return p, true
+	}
+
+	// Some scalar types are slices under the hood.
+	switch p.(type) {
+	case ipParser, hardwareAddrParser, customParser, textParser:
+		return p, true
+	default:
+		return nil, false
+	}
+}
+
+// mapParser is a parser for maps, e.g. "a=1,b=2".
+// Pairs are split with Parameters.PairSeparator or Parameters.Separator when it is empty,
+// keys and values are split with Parameters.KeyValueSeparator.
+type mapParser struct {
+	typ reflect.Type
+	key EnvParser
+	val EnvParser
+}
+
+func (m mapParser) ParseEnv(key string, options Parameters) (any, error) {
+	env, err := getString(key, options)
+	if err != nil {
+		return nil, err
+	}
+
+	pairSep := options.PairSeparator
+	if pairSep == "" {
+		pairSep = options.Separator
+	}
+
+	if pairSep == "" {
+		return nil, newErrMissingSeparator("pair separator")
+	}
+
+	if options.KeyValueSeparator == "" {
+		return nil, newErrMissingSeparator("key/value separator")
+	}
+
+	pairs, err := splitElements(env, pairSep, options)
+	if err != nil {
+		return nil, err
+	}
+
+	val := reflect.MakeMap(m.typ)
+
+	for i, pair := range pairs {
+		k, v, err := m.parsePair(key, pair, options)
+		if err != nil {
+			return nil, &ElementError{
+				Index:  i,
+				Column: -1,
+				Raw:    pair,
+				Err:    err,
+			}
+		}
+
+		if val.MapIndex(k).IsValid() {
+			return nil, &ElementError{
+				Index:  i,
+				Column: -1,
+				Raw:    pair,
+				Err:    newErrInvalidValue(fmt.Sprintf("duplicate key %q", pair)),
+			}
+		}
+
+		val.SetMapIndex(k, v)
+	}
+
+	return val.Interface(), nil
+}
+
+// parsePair parses a single key/value pair of the map.
+func (m mapParser) parsePair(key, pair string, options Parameters) (reflect.Value, reflect.Value, error) {
+	if pair == "" {
+		return reflect.Value{}, reflect.Value{}, newErrEmptyElement("empty pair")
+	}
+
+	rawKey, rawVal, ok := strings.Cut(pair, options.KeyValueSeparator)
+	if !ok {
+		return reflect.Value{}, reflect.Value{},
+			newErrInvalidValue(fmt.Sprintf("missing key/value separator %q in %q", options.KeyValueSeparator, pair))
+	}
+
+	if options.TrimSpace {
+		rawKey, rawVal = strings.TrimSpace(rawKey), strings.TrimSpace(rawVal)
+	}
+
+	if rawKey == "" {
+		return reflect.Value{}, reflect.Value{}, newErrEmptyElement(fmt.Sprintf("empty key in %q", pair))
+	}
+
+	if rawVal == "" {
+		return reflect.Value{}, reflect.Value{}, newErrEmptyElement(fmt.Sprintf("empty value in %q", pair))
+	}
+
+	// Validation options apply to the values only.
+	k, err := parseRaw(m.key, m.typ.Key(), key, rawKey, options.withoutValidation())
+	if err != nil {
+		return reflect.Value{}, reflect.Value{}, fmt.Errorf("map key %q: %w", rawKey, err)
+	}
+
+	v, err := parseRaw(m.val, m.typ.Elem(), key, rawVal, options)
+	if err != nil {
+		return reflect.Value{}, reflect.Value{}, fmt.Errorf("map value of %q: %w", rawKey, err)
+	}
+
+	return k, v, nil
+}
+
+// parseRaw parses the raw value of type t with the parser p, as if it was the value of the variable named by the key.
+func parseRaw(p EnvParser, t reflect.Type, key, raw string, options Parameters) (reflect.Value, error) {
+	options.Source = valueSource{
+		key:   key,
+		value: raw,
+	}
+	options.FileFallback = false
+	options.Expand = false
+
+	v, err := p.ParseEnv(key, options)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	if v == nil {
+		return reflect.Zero(t), nil
+	}
+
+	return reflect.ValueOf(v), nil
+}
+
+// valueSource is a source that holds the value of a single variable.
+type valueSource struct {
+	key   string
+	value string
+}
+
+func (s valueSource) Lookup(key string) (string, bool) {
+	if key != s.key {
+		return "", false
+	}
+
+	return s.value, true
+}
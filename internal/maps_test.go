@@ -0,0 +1,237 @@
+package internal
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_mapParser(t *testing.T) {
+	src := mapSource{
+		"LABELS":    "team:core,env:prod",
+		"LIMITS":    "a=10;b=20",
+		"TYPED":     "1=1s;2=2m",
+		"IPS":       "a=127.0.0.1;b=::1",
+		"DUPLICATE": "1=1s;01=2s",
+		"NO_SEP":    "a=1;b",
+		"EMPTY_KEY": "=1",
+		"EMPTY_VAL": "a=",
+		"BAD_KEY":   "x=1s",
+		"BAD_VAL":   "a=x",
+	}
+
+	type args struct {
+		v       any
+		key     string
+		options Parameters
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    any
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "string values with slice separator",
+			args: args{
+				v:   map[string]string{},
+				key: "LABELS",
+				options: Parameters{
+					Separator:         ",",
+					KeyValueSeparator: ":",
+				},
+			},
+			want: map[string]string{
+				"team": "core",
+				"env":  "prod",
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "pair separator takes precedence",
+			args: args{
+				v:   map[string]int{},
+				key: "LIMITS",
+				options: Parameters{
+					Separator:         ",",
+					PairSeparator:     ";",
+					KeyValueSeparator: "=",
+				},
+			},
+			want: map[string]int{
+				"a": 10,
+				"b": 20,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "typed keys",
+			args: args{
+				v:   map[int]time.Duration{},
+				key: "TYPED",
+				options: Parameters{
+					PairSeparator:     ";",
+					KeyValueSeparator: "=",
+				},
+			},
+			want: map[int]time.Duration{
+				1: time.Second,
+				2: 2 * time.Minute,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "slice backed scalar values",
+			args: args{
+				v:   map[string]net.IP{},
+				key: "IPS",
+				options: Parameters{
+					PairSeparator:     ";",
+					KeyValueSeparator: "=",
+				},
+			},
+			want: map[string]net.IP{
+				"a": net.ParseIP("127.0.0.1"),
+				"b": net.ParseIP("::1"),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "duplicate parsed keys",
+			args: args{
+				v:   map[int]time.Duration{},
+				key: "DUPLICATE",
+				options: Parameters{
+					PairSeparator:     ";",
+					KeyValueSeparator: "=",
+				},
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorIs(t, err, ErrInvalidValue, i...) &&
+					assert.ErrorContains(t, err, `duplicate key "01=2s"`, i...)
+			},
+		},
+		{
+			name: "missing key/value separator",
+			args: args{
+				v:   map[string]int{},
+				key: "NO_SEP",
+				options: Parameters{
+					PairSeparator:     ";",
+					KeyValueSeparator: "=",
+				},
+			},
+			want:    nil,
+			wantErr: errorEqual(t, ErrInvalidValue),
+		},
+		{
+			name: "empty key",
+			args: args{
+				v:   map[string]int{},
+				key: "EMPTY_KEY",
+				options: Parameters{
+					PairSeparator:     ";",
+					KeyValueSeparator: "=",
+				},
+			},
+			want:    nil,
+			wantErr: errorKind(t, ErrEmptyElement),
+		},
+		{
+			name: "empty value",
+			args: args{
+				v:   map[string]int{},
+				key: "EMPTY_VAL",
+				options: Parameters{
+					PairSeparator:     ";",
+					KeyValueSeparator: "=",
+				},
+			},
+			want:    nil,
+			wantErr: errorEqual(t, ErrInvalidValue),
+		},
+		{
+			name: "invalid key",
+			args: args{
+				v:   map[int]time.Duration{},
+				key: "BAD_KEY",
+				options: Parameters{
+					PairSeparator:     ";",
+					KeyValueSeparator: "=",
+				},
+			},
+			want:    nil,
+			wantErr: errorEqual(t, ErrInvalidValue),
+		},
+		{
+			name: "invalid value",
+			args: args{
+				v:   map[string]int{},
+				key: "BAD_VAL",
+				options: Parameters{
+					PairSeparator:     ";",
+					KeyValueSeparator: "=",
+				},
+			},
+			want:    nil,
+			wantErr: errorEqual(t, ErrInvalidValue),
+		},
+		{
+			name: "no separators",
+			args: args{
+				v:   map[string]int{},
+				key: "LIMITS",
+			},
+			want:    nil,
+			wantErr: errorEqual(t, ErrMissingSeparator),
+		},
+		{
+			name: "not set",
+			args: args{
+				v:   map[string]int{},
+				key: "MISSING",
+				options: Parameters{
+					PairSeparator:     ";",
+					KeyValueSeparator: "=",
+				},
+			},
+			want:    nil,
+			wantErr: errorEqual(t, ErrNotSet),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.options.Source = src
+
+			got, err := NewEnvParser(tt.args.v).ParseEnv(tt.args.key, tt.args.options)
+			if !tt.wantErr(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_lookupMapParser(t *testing.T) {
+	_, ok := LookupEnvParser(map[string][]int{})
+	assert.False(t, ok)
+
+	_, ok = LookupEnvParser(map[string]*int{})
+	assert.False(t, ok)
+
+	_, ok = LookupEnvParser(map[string]notsupported{})
+	assert.False(t, ok)
+
+	_, ok = LookupEnvParser(map[notsupported]string{})
+	assert.False(t, ok)
+
+	_, ok = LookupEnvParser(map[netip.Addr]net.HardwareAddr{})
+	assert.True(t, ok)
+}
@@ -0,0 +1,105 @@
+package internal
+
+import (
+	"reflect"
+)
+
+// lookupNestedSliceParser returns EnvParser for two-level slice of the supported scalar type, e.g. [][]string.
+func lookupNestedSliceParser(t reflect.Type) (EnvParser, bool) {
+	if t == nil || t.Kind() != reflect.Slice || t.Elem().Kind() != reflect.Slice {
+		return nil, false
+	}
+
+	elem, ok := lookupScalarParser(t.Elem().Elem())
+	if !ok {
+		return nil, false
+	}
+
+	return nestedSliceParser{
+		typ:  t,
+		elem: elem,
+	}, true
+}
+
+// nestedSliceParser is a parser for two-level slices, e.g. "h1,h2;h3,h4".
+// Rows are split with Parameters.OuterSeparator, elements of the row are split
+// with Parameters.InnerSeparator or Parameters.Separator when it is empty.
+type nestedSliceParser struct {
+	typ  reflect.Type
+	elem EnvParser
+}
+
+func (n nestedSliceParser) ParseEnv(key string, options Parameters) (any, error) {
+	env, err := getString(key, options)
+	if err != nil {
+		return nil, err
+	}
+
+	innerSep := options.InnerSeparator
+	if innerSep == "" {
+		innerSep = options.Separator
+	}
+
+	if options.OuterSeparator == "" {
+		return nil, newErrMissingSeparator("outer separator")
+	}
+
+	if innerSep == "" {
+		return nil, newErrMissingSeparator("inner separator")
+	}
+
+	rowType := n.typ.Elem()
+	rows, err := splitElements(env, options.OuterSeparator, options)
+	if err != nil {
+		return nil, err
+	}
+
+	val := reflect.MakeSlice(n.typ, 0, len(rows))
+
+	// Empty elements are rejected below to report the row and the column.
+	elemOptions := options
+	if elemOptions.EmptyElements == EmptyReject {
+		elemOptions.EmptyElements = EmptyKeep
+	}
+
+	for i, rawRow := range rows {
+		elems, err := splitElements(rawRow, innerSep, elemOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		row := reflect.MakeSlice(rowType, 0, len(elems))
+
+		for j, raw := range elems {
+			v, err := n.parseElement(rowType.Elem(), key, raw, options)
+			if err != nil {
+				return nil, newNestedElementError(i, j, raw, err)
+			}
+
+			row = reflect.Append(row, v)
+		}
+
+		val = reflect.Append(val, row)
+	}
+
+	return val.Interface(), nil
+}
+
+// parseElement parses the element of the row. Like in string slices, empty strings are kept
+// and checked against the validation options, empty elements of other types are invalid.
+func (n nestedSliceParser) parseElement(t reflect.Type, key, raw string, options Parameters) (reflect.Value, error) {
+	if raw != "" {
+		return parseRaw(n.elem, t, key, raw, options)
+	}
+
+	if options.EmptyElements == EmptyReject || t != reflect.TypeFor[string]() {
+		return reflect.Value{}, ErrEmptyElement
+	}
+
+	v, err := checkString(raw, options)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	return reflect.ValueOf(v), nil
+}
@@ -0,0 +1,217 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_nestedSliceParser(t *testing.T) {
+	src := mapSource{
+		"SHARDS":   "h1,h2;h3,h4",
+		"TIMEOUTS": "1s;2s|3s",
+		"INVALID":  "1,2;3,x",
+		"EMPTY":    "1,2;,3",
+		"BLANKS":   "a,,b;c",
+	}
+
+	type args struct {
+		v       any
+		key     string
+		options Parameters
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    any
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "strings with slice separator",
+			args: args{
+				v:   [][]string{},
+				key: "SHARDS",
+				options: Parameters{
+					Separator:      ",",
+					OuterSeparator: ";",
+				},
+			},
+			want:    [][]string{{"h1", "h2"}, {"h3", "h4"}},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "inner separator takes precedence",
+			args: args{
+				v:   [][]time.Duration{},
+				key: "TIMEOUTS",
+				options: Parameters{
+					Separator:      ",",
+					InnerSeparator: ";",
+					OuterSeparator: "|",
+				},
+			},
+			want:    [][]time.Duration{{time.Second, 2 * time.Second}, {3 * time.Second}},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "invalid element reports row and column",
+			args: args{
+				v:   [][]int{},
+				key: "INVALID",
+				options: Parameters{
+					InnerSeparator: ",",
+					OuterSeparator: ";",
+				},
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				var elemErr *ElementError
+
+				return assert.ErrorIs(t, err, ErrInvalidValue, i...) &&
+					assert.ErrorContains(t, err, `element [1][1] "x"`, i...) &&
+					assert.ErrorAs(t, err, &elemErr, i...) &&
+					assert.Equal(t, 1, elemErr.Index, i...) &&
+					assert.Equal(t, 1, elemErr.Column, i...)
+			},
+		},
+		{
+			name: "empty element reports row and column",
+			args: args{
+				v:   [][]int{},
+				key: "EMPTY",
+				options: Parameters{
+					InnerSeparator: ",",
+					OuterSeparator: ";",
+				},
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				var elemErr *ElementError
+
+				return assert.ErrorIs(t, err, ErrEmptyElement, i...) &&
+					assert.ErrorContains(t, err, "element [1][0]", i...) &&
+					assert.ErrorAs(t, err, &elemErr, i...) &&
+					assert.Equal(t, 1, elemErr.Index, i...) &&
+					assert.Equal(t, 0, elemErr.Column, i...)
+			},
+		},
+		{
+			name: "empty strings are kept",
+			args: args{
+				v:   [][]string{},
+				key: "BLANKS",
+				options: Parameters{
+					InnerSeparator: ",",
+					OuterSeparator: ";",
+				},
+			},
+			want:    [][]string{{"a", "", "b"}, {"c"}},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "empty strings are dropped",
+			args: args{
+				v:   [][]string{},
+				key: "BLANKS",
+				options: Parameters{
+					InnerSeparator: ",",
+					OuterSeparator: ";",
+					EmptyElements:  EmptyDrop,
+				},
+			},
+			want:    [][]string{{"a", "b"}, {"c"}},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "empty string not allowed",
+			args: args{
+				v:   [][]string{},
+				key: "BLANKS",
+				options: Parameters{
+					InnerSeparator: ",",
+					OuterSeparator: ";",
+					OneOf:          []any{"a", "b", "c"},
+				},
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorIs(t, err, ErrNotAllowed, i...) &&
+					assert.NotErrorIs(t, err, ErrEmptyElement, i...) &&
+					assert.ErrorContains(t, err, `element [0][1] ""`, i...)
+			},
+		},
+		{
+			name: "empty strings are rejected",
+			args: args{
+				v:   [][]string{},
+				key: "BLANKS",
+				options: Parameters{
+					InnerSeparator: ",",
+					OuterSeparator: ";",
+					EmptyElements:  EmptyReject,
+				},
+			},
+			want: nil,
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				var elemErr *ElementError
+
+				return assert.ErrorIs(t, err, ErrEmptyElement, i...) &&
+					assert.ErrorAs(t, err, &elemErr, i...) &&
+					assert.Equal(t, 0, elemErr.Index, i...) &&
+					assert.Equal(t, 1, elemErr.Column, i...)
+			},
+		},
+		{
+			name: "no outer separator",
+			args: args{
+				v:   [][]string{},
+				key: "SHARDS",
+				options: Parameters{
+					Separator: ",",
+				},
+			},
+			want:    nil,
+			wantErr: errorEqual(t, ErrMissingSeparator),
+		},
+		{
+			name: "not set",
+			args: args{
+				v:   [][]string{},
+				key: "MISSING",
+				options: Parameters{
+					Separator:      ",",
+					OuterSeparator: ";",
+				},
+			},
+			want:    nil,
+			wantErr: errorEqual(t, ErrNotSet),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.options.Source = src
+
+			got, err := NewEnvParser(tt.args.v).ParseEnv(tt.args.key, tt.args.options)
+			if !tt.wantErr(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_lookupNestedSliceParser(t *testing.T) {
+	_, ok := LookupEnvParser([][][]int{})
+	assert.False(t, ok)
+
+	_, ok = LookupEnvParser([][]notsupported{})
+	assert.False(t, ok)
+
+	p, ok := LookupEnvParser([][]int{})
+	assert.True(t, ok)
+	assert.IsType(t, nestedSliceParser{}, p)
+}
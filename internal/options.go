@@ -1,10 +1,124 @@
 package internal
 
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
 // Parameters is a struct for holding parameters for the parser.
 // It is used to pass parameters to the parser.
 // Separator is a separator for the environment variable that holds slice.
 // Layout is a layout for the time.Time.
+// Layouts are the layouts for the time.Time tried in order when Layout is empty.
+// Location is the location of the time.Time values, time.Parse rules apply when it is nil.
+// Prefix is a key prefix for the struct binding.
+// PrefixDelimiter is a delimiter between the key prefix segments for the struct binding.
+// RequireAll makes every field required for the struct binding unless it is marked optional.
+// Source is a source of raw values, the process environment is used when it is nil.
+// FileFallback enables reading the value from the file named by KEY_FILE when KEY is not set.
+// Expand enables expansion of ${VAR} references in the raw value.
+// PairSeparator is a separator between the pairs of the environment variable that holds map,
+// Separator is used when it is empty.
+// KeyValueSeparator is a separator between the key and the value of the map pair.
+// OuterSeparator is a separator between the rows of the environment variable that holds two-level slice.
+// InnerSeparator is a separator between the elements of the two-level slice row, Separator is used when it is empty.
+// Sensitive marks the value as sensitive, so that errors do not disclose it.
+// Base is a base of the integers from 2 to 36, 10 is used when it is 0.
+// AutoBase detects the base of the integers from the prefix, Base is ignored then.
+// Min and Max are the bounds of the numbers, durations and byte sizes, they are not checked when nil.
+// OneOf is a list of the allowed values of the strings and the numbers, any value is allowed when it is empty.
+// IgnoreCase makes OneOf strings case-insensitive.
+// Pattern is a regular expression the strings must match, it is not checked when nil.
+// TrimSpace trims leading and trailing white space of the slice elements and the map pairs.
+// EmptyElements is the policy for the empty slice elements and map pairs.
 type Parameters struct {
-	Separator string
-	Layout    string
+	Separator         string
+	Layout            string
+	Layouts           []string
+	Location          *time.Location
+	Prefix            string
+	PrefixDelimiter   string
+	RequireAll        bool
+	Source            Source
+	FileFallback      bool
+	Expand            bool
+	PairSeparator     string
+	KeyValueSeparator string
+	OuterSeparator    string
+	InnerSeparator    string
+	Sensitive         bool
+	Base              int
+	AutoBase          bool
+	Min               any
+	Max               any
+	OneOf             []any
+	IgnoreCase        bool
+	Pattern           *regexp.Regexp
+	TrimSpace         bool
+	EmptyElements     EmptyPolicy
+}
+
+// EmptyPolicy is the policy for the empty slice elements and map pairs.
+type EmptyPolicy int
+
+const (
+	// EmptyKeep passes empty elements to the element parser, it is the default.
+	// Strings stay empty, other types and map pairs report ErrEmptyElement.
+	EmptyKeep EmptyPolicy = iota
+	// EmptyDrop drops empty elements.
+	EmptyDrop
+	// EmptyReject reports empty elements of any type with ErrEmptyElement.
+	EmptyReject
+)
+
+// Bounds of Parameters.Base.
+const (
+	minBase = 2
+	maxBase = 36
+)
+
+// numberBase returns the base for strconv integer parsing.
+// AutoBase is 0 for strconv: the base is detected from the 0x, 0o, 0b or 0 prefix
+// and underscores are allowed between the digits, e.g. 0xFF00 or 1_000_000.
+func (p Parameters) numberBase() (int, error) {
+	switch {
+	case p.AutoBase:
+		return 0, nil
+	case p.Base == 0:
+		return decimalBase, nil
+	case p.Base < minBase || p.Base > maxBase:
+		return 0, fmt.Errorf("%w %d, it must be from %d to %d", ErrInvalidBase, p.Base, minBase, maxBase)
+	default:
+		return p.Base, nil
+	}
+}
+
+// withoutValidation returns parameters without the options that validate the values, e.g. for the map keys.
+func (p Parameters) withoutValidation() Parameters {
+	p.Min, p.Max = nil, nil
+	p.OneOf = nil
+	p.Pattern = nil
+
+	return p
+}
+
+// lookup retrieves the raw value of the variable named by the key from the parameters source.
+func (p Parameters) lookup(key string) (string, bool) {
+	if p.Source == nil {
+		return envSource{}.Lookup(key)
+	}
+
+	return p.Source.Lookup(key)
+}
+
+// RawValue returns the raw value of the variable named by the key as the parsers see it,
+// after the file fallback and the expansion. The boolean result reports whether the value is set.
+func RawValue(key string, p Parameters) (string, bool) {
+	env, err := getString(key, p)
+	if err != nil {
+		return "", false
+	}
+
+	return env, true
 }
@@ -5,7 +5,6 @@
 	"net"
 	"net/netip"
 	"net/url"
-	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -20,47 +19,64 @@
 	bitSize128  = 128
 )
 
-func getString(key string) (string, error) {
-	env, ok := os.LookupEnv(key)
+func getString(key string, p Parameters) (string, error) {
+	env, ok := p.lookup(key)
+
+	if p.FileFallback {
+		var err error
+
+		env, ok, err = lookupFile(key, env, ok && env != "", p)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	if !ok || env == "" {
 		return "", newErrNotSet(fmt.Sprintf("%q", key))
 	}
 
+	if p.Expand {
+		var err error
+
+		env, err = expandValue(key, env, p)
+		if err != nil {
+			return "", err
+		}
+
+		if env == "" {
+			return "", newErrNotSet(fmt.Sprintf("%q", key))
+		}
+	}
+
 	return env, nil
 }
 
-func getBool(key string) (bool, error) {
-	env, err := getString(key)
+func getBool(key string, p Parameters) (bool, error) {
+	env, err := getString(key, p)
 	if err != nil {
 		return false, err
 	}
 
 	val, err := strconv.ParseBool(env)
 	if err != nil {
-		return false, newErrInvalidValue(err.Error())
+		return false, wrapErrInvalidValue(err)
 	}
 
 	return val, nil
 }
 
-func getBoolSlice(key, sep string) ([]bool, error) {
-	env, err := getString(key)
+func getBoolSlice(key string, p Parameters) ([]bool, error) {
+	val, err := getStringSlice(key, p)
 	if err != nil {
 		return nil, err
 	}
 
-	if sep == "" {
-		return nil, ErrInvalidValue
-	}
-
-	val := strings.Split(env, sep)
-
 	b := make([]bool, 0, len(val))
 
-	for _, s := range val {
+	for i, s := range val {
 		v, err := strconv.ParseBool(s)
 		if err != nil {
-			return nil, newErrInvalidValue(err.Error())
+			return nil, newElementError(i, s, err)
 		}
 
 		b = append(b, v)
@@ -69,47 +85,133 @@ func getBoolSlice(key, sep string) ([]bool, error) {
 	return b, nil
 }
 
-func getStringSlice(key, sep string) ([]string, error) {
-	env, err := getString(key)
+func getStringSlice(key string, p Parameters) ([]string, error) {
+	env, err := getString(key, p)
 	if err != nil {
 		return nil, err
 	}
 
-	if sep == "" {
-		return nil, ErrInvalidValue
+	if p.Separator == "" {
+		return nil, newErrMissingSeparator("slice separator")
 	}
 
-	val := strings.Split(env, sep)
+	return splitElements(env, p.Separator, p)
+}
+
+// splitElements splits s into the slice elements with sep, trimming them and applying
+// the empty element policy of the parameters.
+func splitElements(s, sep string, p Parameters) ([]string, error) {
+	parts := strings.Split(s, sep)
+	val := make([]string, 0, len(parts))
+
+	for i, e := range parts {
+		if p.TrimSpace {
+			e = strings.TrimSpace(e)
+		}
+
+		if e == "" {
+			switch p.EmptyElements {
+			case EmptyDrop:
+				continue
+			case EmptyReject:
+				return nil, newElementError(i, e, ErrEmptyElement)
+			case EmptyKeep:
+			}
+		}
+
+		val = append(val, e)
+	}
 
 	return val, nil
 }
 
-func parseNumberGen[T Number](raw string) (T, error) {
+// getStringValue returns the string value of the variable, checked against the validation options.
+func getStringValue(key string, p Parameters) (string, error) {
+	env, err := getString(key, p)
+	if err != nil {
+		return "", err
+	}
+
+	return checkString(env, p)
+}
+
+// getStringValueSlice returns the string slice value of the variable, each element is checked
+// against the validation options.
+func getStringValueSlice(key string, p Parameters) ([]string, error) {
+	env, err := getStringSlice(key, p)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, s := range env {
+		v, err := checkString(s, p)
+		if err != nil {
+			return nil, newElementError(i, s, err)
+		}
+
+		env[i] = v
+	}
+
+	return env, nil
+}
+
+// checkString checks the string against Parameters.Pattern and Parameters.OneOf.
+func checkString(s string, p Parameters) (string, error) {
+	if err := checkPattern(s, p); err != nil {
+		return "", err
+	}
+
+	return checkOneOfString(s, p)
+}
+
+func parseNumberGen[T Number](raw string, p Parameters) (T, error) {
+	base, err := p.numberBase()
+	if err != nil {
+		return 0, err
+	}
+
+	val, err := parseNumberBase[T](raw, base)
+	if err != nil {
+		return val, err
+	}
+
+	if err = checkBounds(val, p); err != nil {
+		return 0, err
+	}
+
+	if err = checkOneOfNumber(val, p); err != nil {
+		return 0, err
+	}
+
+	return val, nil
+}
+
+func parseNumberBase[T Number](raw string, base int) (T, error) {
 	var zero T
 
 	switch any(zero).(type) {
 	case int:
-		return parseSignedNumber[T](raw, decimalBase, strconv.IntSize)
+		return parseSignedNumber[T](raw, base, strconv.IntSize)
 	case int8:
-		return parseSignedNumber[T](raw, decimalBase, bitSize8)
+		return parseSignedNumber[T](raw, base, bitSize8)
 	case int16:
-		return parseSignedNumber[T](raw, decimalBase, bitSize16)
+		return parseSignedNumber[T](raw, base, bitSize16)
 	case int32:
-		return parseSignedNumber[T](raw, decimalBase, bitSize32)
+		return parseSignedNumber[T](raw, base, bitSize32)
 	case int64:
-		return parseSignedNumber[T](raw, decimalBase, bitSize64)
+		return parseSignedNumber[T](raw, base, bitSize64)
 	case uint:
-		return parseUnsignedNumber[T](raw, decimalBase, strconv.IntSize)
+		return parseUnsignedNumber[T](raw, base, strconv.IntSize)
 	case uint8:
-		return parseUnsignedNumber[T](raw, decimalBase, bitSize8)
+		return parseUnsignedNumber[T](raw, base, bitSize8)
 	case uint16:
-		return parseUnsignedNumber[T](raw, decimalBase, bitSize16)
+		return parseUnsignedNumber[T](raw, base, bitSize16)
 	case uint32:
-		return parseUnsignedNumber[T](raw, decimalBase, bitSize32)
+		return parseUnsignedNumber[T](raw, base, bitSize32)
 	case uint64:
-		return parseUnsignedNumber[T](raw, decimalBase, bitSize64)
+		return parseUnsignedNumber[T](raw, base, bitSize64)
 	case uintptr:
-		return parseUnsignedNumber[T](raw, decimalBase, strconv.IntSize)
+		return parseUnsignedNumber[T](raw, base, strconv.IntSize)
 	case float32:
 		return parseFloatNumber[T](raw, bitSize32)
 	case float64:
@@ -124,7 +226,7 @@ func parseSignedNumber[T Number](raw string, base, bits int) (T, error) {
 
 	val, err := strconv.ParseInt(raw, base, bits)
 	if err != nil {
-		return zero, newErrInvalidValue(err.Error())
+		return zero, wrapErrInvalidValue(err)
 	}
 
 	return T(val), nil
@@ -135,7 +237,7 @@ func parseUnsignedNumber[T Number](raw string, base, bits int) (T, error) {
 
 	val, err := strconv.ParseUint(raw, base, bits)
 	if err != nil {
-		return zero, newErrInvalidValue(err.Error())
+		return zero, wrapErrInvalidValue(err)
 	}
 
 	return T(val), nil
@@ -146,21 +248,26 @@ func parseFloatNumber[T Number](raw string, bits int) (T, error) {
 
 	val, err := strconv.ParseFloat(raw, bits)
 	if err != nil {
-		return zero, newErrInvalidValue(err.Error())
+		return zero, wrapErrInvalidValue(err)
 	}
 
 	return T(val), nil
 }
 
-func parseNumberSliceGen[T Number](raw []string) ([]T, error) {
+func parseNumberSliceGen[T Number](raw []string, p Parameters) ([]T, error) {
 	var zero []T
 
+	// The base is checked once, it is not an element error.
+	if _, err := p.numberBase(); err != nil {
+		return zero, err
+	}
+
 	val := make([]T, 0, len(raw))
 
-	for _, s := range raw {
-		v, err := parseNumberGen[T](s)
+	for i, s := range raw {
+		v, err := parseNumberGen[T](s, p)
 		if err != nil {
-			return zero, err
+			return zero, newElementError(i, s, err)
 		}
 
 		val = append(val, v)
@@ -169,64 +276,91 @@ func parseNumberSliceGen[T Number](raw []string) ([]T, error) {
 	return val, nil
 }
 
-func getNumberSliceGen[T Number](key, sep string) ([]T, error) {
-	env, err := getStringSlice(key, sep)
+func getNumberSliceGen[T Number](key string, p Parameters) ([]T, error) {
+	env, err := getStringSlice(key, p)
 	if err != nil {
 		return nil, err
 	}
 
-	return parseNumberSliceGen[T](env)
+	return parseNumberSliceGen[T](env, p)
 }
 
-func getNumberGen[T Number](key string) (T, error) {
-	env, err := getString(key)
+func getNumberGen[T Number](key string, p Parameters) (T, error) {
+	env, err := getString(key, p)
 	if err != nil {
 		return 0, err
 	}
 
-	return parseNumberGen[T](env)
+	return parseNumberGen[T](env, p)
 }
 
-func getDuration(key string) (time.Duration, error) {
-	env, err := getString(key)
+func getDuration(key string, p Parameters) (time.Duration, error) {
+	env, err := getString(key, p)
 	if err != nil {
 		return 0, err
 	}
 
-	val, err := time.ParseDuration(env)
+	return parseDuration(env, p)
+}
+
+func parseDuration(raw string, p Parameters) (time.Duration, error) {
+	val, err := time.ParseDuration(raw)
 	if err != nil {
-		return 0, newErrInvalidValue(err.Error())
+		return 0, wrapErrSyntax(err)
+	}
+
+	if err = checkBounds(val, p); err != nil {
+		return 0, err
+	}
+
+	if err = checkOneOfNumber(val, p); err != nil {
+		return 0, err
 	}
 
 	return val, nil
 }
 
-func getTime(key, layout string) (time.Time, error) {
-	env, err := getString(key)
+func getTime(key string, p Parameters) (time.Time, error) {
+	env, err := getString(key, p)
 	if err != nil {
 		return time.Time{}, err
 	}
 
-	val, err := time.Parse(layout, env)
+	return parseTime(env, p)
+}
+
+// getLocation loads the time zone named by the value, e.g. "Europe/Berlin", "UTC" or "Local",
+// from the system zoneinfo database.
+func getLocation(key string, p Parameters) (*time.Location, error) {
+	env, err := getString(key, p)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := time.LoadLocation(env)
 	if err != nil {
-		return time.Time{}, newErrInvalidValue(err.Error())
+		return nil, wrapErrSyntax(err)
 	}
 
 	return val, nil
 }
 
-func getTimeSlice(key, layout, sep string) ([]time.Time, error) {
-	env, err := getStringSlice(key, sep)
+func getTimeSlice(key string, p Parameters) ([]time.Time, error) {
+	env, err := getStringSlice(key, p)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(p.timeLayouts()) == 0 {
+		return nil, ErrMissingLayout
+	}
+
 	val := make([]time.Time, 0, len(env))
 
-	for _, s := range env {
-		v, err := time.Parse(layout, s)
+	for i, s := range env {
+		v, err := parseTime(s, p)
 		if err != nil {
-			return nil, newErrInvalidValue(err.Error())
+			return nil, newElementError(i, s, err)
 		}
 
 		val = append(val, v)
@@ -235,18 +369,18 @@ func getTimeSlice(key, layout, sep string) ([]time.Time, error) {
 	return val, nil
 }
 
-func getDurationSlice(key, sep string) ([]time.Duration, error) {
-	env, err := getStringSlice(key, sep)
+func getDurationSlice(key string, p Parameters) ([]time.Duration, error) {
+	env, err := getStringSlice(key, p)
 	if err != nil {
 		return nil, err
 	}
 
 	val := make([]time.Duration, 0, len(env))
 
-	for _, s := range env {
-		v, err := time.ParseDuration(s)
+	for i, s := range env {
+		v, err := parseDuration(s, p)
 		if err != nil {
-			return nil, newErrInvalidValue(err.Error())
+			return nil, newElementError(i, s, err)
 		}
 
 		val = append(val, v)
@@ -255,32 +389,32 @@ func getDurationSlice(key, sep string) ([]time.Duration, error) {
 	return val, nil
 }
 
-func getURL(key string) (url.URL, error) {
-	env, err := getString(key)
+func getURL(key string, p Parameters) (url.URL, error) {
+	env, err := getString(key, p)
 	if err != nil {
 		return url.URL{}, err
 	}
 
 	val, err := url.Parse(env)
 	if err != nil {
-		return url.URL{}, newErrInvalidValue(err.Error())
+		return url.URL{}, wrapErrSyntax(err)
 	}
 
 	return *val, nil
 }
 
-func getURLSlice(key, sep string) ([]url.URL, error) {
-	env, err := getStringSlice(key, sep)
+func getURLSlice(key string, p Parameters) ([]url.URL, error) {
+	env, err := getStringSlice(key, p)
 	if err != nil {
 		return nil, err
 	}
 
 	val := make([]url.URL, 0, len(env))
 
-	for _, s := range env {
+	for i, s := range env {
 		v, err := url.Parse(s)
 		if err != nil {
-			return nil, newErrInvalidValue(err.Error())
+			return nil, newElementError(i, s, wrapErrSyntax(err))
 		}
 
 		val = append(val, *v)
@@ -289,32 +423,32 @@ func getURLSlice(key, sep string) ([]url.URL, error) {
 	return val, nil
 }
 
-func getIP(key string) (net.IP, error) {
-	env, err := getString(key)
+func getIP(key string, p Parameters) (net.IP, error) {
+	env, err := getString(key, p)
 	if err != nil {
 		return nil, err
 	}
 
 	val := net.ParseIP(env)
 	if val == nil {
-		return nil, ErrInvalidValue
+		return nil, wrapErrSyntax(newErrInvalidIP(env))
 	}
 
 	return val, nil
 }
 
-func getIPSlice(key, sep string) ([]net.IP, error) {
-	env, err := getStringSlice(key, sep)
+func getIPSlice(key string, p Parameters) ([]net.IP, error) {
+	env, err := getStringSlice(key, p)
 	if err != nil {
 		return nil, err
 	}
 
 	val := make([]net.IP, 0, len(env))
 
-	for _, s := range env {
+	for i, s := range env {
 		v := net.ParseIP(s)
 		if v == nil {
-			return nil, ErrInvalidValue
+			return nil, newElementError(i, s, wrapErrSyntax(newErrInvalidIP(s)))
 		}
 
 		val = append(val, v)
@@ -323,32 +457,32 @@ func getIPSlice(key, sep string) ([]net.IP, error) {
 	return val, nil
 }
 
-func getNetIPAddr(key string) (netip.Addr, error) {
-	env, err := getString(key)
+func getNetIPAddr(key string, p Parameters) (netip.Addr, error) {
+	env, err := getString(key, p)
 	if err != nil {
 		return netip.Addr{}, err
 	}
 
 	val, err := netip.ParseAddr(env)
 	if err != nil {
-		return netip.Addr{}, newErrInvalidValue(err.Error())
+		return netip.Addr{}, wrapErrSyntax(err)
 	}
 
 	return val, nil
 }
 
-func getNetIPAddrSlice(key, sep string) ([]netip.Addr, error) {
-	env, err := getStringSlice(key, sep)
+func getNetIPAddrSlice(key string, p Parameters) ([]netip.Addr, error) {
+	env, err := getStringSlice(key, p)
 	if err != nil {
 		return nil, err
 	}
 
 	val := make([]netip.Addr, 0, len(env))
 
-	for _, s := range env {
+	for i, s := range env {
 		v, err := netip.ParseAddr(s)
 		if err != nil {
-			return nil, newErrInvalidValue(err.Error())
+			return nil, newElementError(i, s, wrapErrSyntax(err))
 		}
 
 		val = append(val, v)
@@ -357,32 +491,32 @@ func getNetIPAddrSlice(key, sep string) ([]netip.Addr, error) {
 	return val, nil
 }
 
-func getNetIPPrefix(key string) (netip.Prefix, error) {
-	env, err := getString(key)
+func getNetIPPrefix(key string, p Parameters) (netip.Prefix, error) {
+	env, err := getString(key, p)
 	if err != nil {
 		return netip.Prefix{}, err
 	}
 
 	val, err := netip.ParsePrefix(env)
 	if err != nil {
-		return netip.Prefix{}, newErrInvalidValue(err.Error())
+		return netip.Prefix{}, wrapErrSyntax(err)
 	}
 
 	return val, nil
 }
 
-func getNetIPPrefixSlice(key, sep string) ([]netip.Prefix, error) {
-	env, err := getStringSlice(key, sep)
+func getNetIPPrefixSlice(key string, p Parameters) ([]netip.Prefix, error) {
+	env, err := getStringSlice(key, p)
 	if err != nil {
 		return nil, err
 	}
 
 	val := make([]netip.Prefix, 0, len(env))
 
-	for _, s := range env {
+	for i, s := range env {
 		v, err := netip.ParsePrefix(s)
 		if err != nil {
-			return nil, newErrInvalidValue(err.Error())
+			return nil, newElementError(i, s, wrapErrSyntax(err))
 		}
 
 		val = append(val, v)
@@ -391,32 +525,37 @@ func getNetIPPrefixSlice(key, sep string) ([]netip.Prefix, error) {
 	return val, nil
 }
 
-func getHardwareAddr(key string) (net.HardwareAddr, error) {
-	env, err := getString(key)
+// newErrInvalidIP reports the value that is not an IP address.
+func newErrInvalidIP(raw string) error {
+	return fmt.Errorf("invalid IP address %q", raw)
+}
+
+func getHardwareAddr(key string, p Parameters) (net.HardwareAddr, error) {
+	env, err := getString(key, p)
 	if err != nil {
 		return nil, err
 	}
 
 	val, err := net.ParseMAC(env)
 	if err != nil {
-		return nil, newErrInvalidValue(err.Error())
+		return nil, wrapErrSyntax(err)
 	}
 
 	return val, nil
 }
 
-func getHardwareAddrSlice(key, sep string) ([]net.HardwareAddr, error) {
-	env, err := getStringSlice(key, sep)
+func getHardwareAddrSlice(key string, p Parameters) ([]net.HardwareAddr, error) {
+	env, err := getStringSlice(key, p)
 	if err != nil {
 		return nil, err
 	}
 
 	val := make([]net.HardwareAddr, 0, len(env))
 
-	for _, s := range env {
+	for i, s := range env {
 		v, err := net.ParseMAC(s)
 		if err != nil {
-			return nil, newErrInvalidValue(err.Error())
+			return nil, newElementError(i, s, wrapErrSyntax(err))
 		}
 
 		val = append(val, v)
@@ -430,7 +569,7 @@ func parseComplexGen[T Complex](raw string) (T, error) {
 
 	val, err := strconv.ParseComplex(raw, complexBits[T]())
 	if err != nil {
-		return zero, newErrInvalidValue(err.Error())
+		return zero, wrapErrInvalidValue(err)
 	}
 
 	return T(val), nil
@@ -454,10 +593,10 @@ func parseComplexSliceGen[T Complex](raw []string) ([]T, error) {
 
 	val := make([]T, 0, len(raw))
 
-	for _, s := range raw {
+	for i, s := range raw {
 		v, err := parseComplexGen[T](s)
 		if err != nil {
-			return zero, err
+			return zero, newElementError(i, s, err)
 		}
 
 		val = append(val, v)
@@ -466,8 +605,8 @@ func parseComplexSliceGen[T Complex](raw []string) ([]T, error) {
 	return val, nil
 }
 
-func getComplexSliceGen[T Complex](key, sep string) ([]T, error) {
-	env, err := getStringSlice(key, sep)
+func getComplexSliceGen[T Complex](key string, p Parameters) ([]T, error) {
+	env, err := getStringSlice(key, p)
 	if err != nil {
 		return nil, err
 	}
@@ -475,8 +614,8 @@ func getComplexSliceGen[T Complex](key, sep string) ([]T, error) {
 	return parseComplexSliceGen[T](env)
 }
 
-func getComplexGen[T Complex](key string) (T, error) {
-	env, err := getString(key)
+func getComplexGen[T Complex](key string, p Parameters) (T, error) {
+	env, err := getString(key, p)
 	if err != nil {
 		return 0, err
 	}
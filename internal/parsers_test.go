@@ -4,6 +4,8 @@
 	"net"
 	"net/netip"
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -25,7 +27,7 @@ func Benchmark_float64SliceOrDefault(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		_, err := getNumberSliceGen[float32](testEnvKey, ",")
+		_, err := getNumberSliceGen[float32](testEnvKey, Parameters{Separator: ","})
 		require.NoError(b, err)
 	}
 }
@@ -100,7 +102,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberGen[int](tt.args.key)
+			got, err := getNumberGen[int](tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -167,7 +169,137 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getString(tt.args.key)
+			got, err := getString(tt.args.key, Parameters{})
+			if !tt.expected.wantErr(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.expected.val, got)
+		})
+	}
+}
+
+func Test_getStringSource(t *testing.T) {
+	t.Setenv(testEnvKey, "from-env")
+
+	p := Parameters{
+		Source: mapSource{
+			testEnvKey: "from-source",
+			"GH_EMPTY": "",
+		},
+	}
+
+	got, err := getString(testEnvKey, p)
+	require.NoError(t, err)
+	assert.Equal(t, "from-source", got)
+
+	_, err = getString("GH_EMPTY", p)
+	errorEqual(t, ErrNotSet)(t, err)
+
+	_, err = getString("GH_NOT_IN_SOURCE", p)
+	errorEqual(t, ErrNotSet)(t, err)
+}
+
+func Test_getStringFileFallback(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(path, []byte("  s3cr3t\n"), 0o600))
+
+	type expected struct {
+		val     string
+		wantErr assert.ErrorAssertionFunc
+	}
+
+	tests := []struct {
+		name     string
+		src      mapSource
+		disabled bool
+		expected expected
+	}{
+		{
+			name: "key set",
+			src: mapSource{
+				"PASSWORD": "plain",
+			},
+			expected: expected{
+				val:     "plain",
+				wantErr: assert.NoError,
+			},
+		},
+		{
+			name: "file set",
+			src: mapSource{
+				"PASSWORD_FILE": path,
+			},
+			expected: expected{
+				val:     "s3cr3t",
+				wantErr: assert.NoError,
+			},
+		},
+		{
+			name: "empty key, file set",
+			src: mapSource{
+				"PASSWORD":      "",
+				"PASSWORD_FILE": path,
+			},
+			expected: expected{
+				val:     "s3cr3t",
+				wantErr: assert.NoError,
+			},
+		},
+		{
+			name: "both set",
+			src: mapSource{
+				"PASSWORD":      "plain",
+				"PASSWORD_FILE": path,
+			},
+			expected: expected{
+				wantErr: func(t assert.TestingT, err error, i ...any) bool {
+					return assert.ErrorIs(t, err, ErrInvalidValue, i...) &&
+						assert.ErrorContains(t, err, `both "PASSWORD" and "PASSWORD_FILE" are set`, i...)
+				},
+			},
+		},
+		{
+			name: "file unreadable",
+			src: mapSource{
+				"PASSWORD_FILE": filepath.Join(dir, "missing"),
+			},
+			expected: expected{
+				wantErr: func(t assert.TestingT, err error, i ...any) bool {
+					return assert.ErrorIs(t, err, ErrInvalidValue, i...) &&
+						assert.ErrorContains(t, err, `failed to read "PASSWORD_FILE"`, i...)
+				},
+			},
+		},
+		{
+			name: "nothing set",
+			src:  mapSource{},
+			expected: expected{
+				wantErr: errorEqual(t, ErrNotSet),
+			},
+		},
+		{
+			name: "fallback disabled",
+			src: mapSource{
+				"PASSWORD_FILE": path,
+			},
+			disabled: true,
+			expected: expected{
+				wantErr: errorEqual(t, ErrNotSet),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Parameters{
+				Source:       tt.src,
+				FileFallback: !tt.disabled,
+			}
+
+			got, err := getString("PASSWORD", p)
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -247,7 +379,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberGen[int64](tt.args.key)
+			got, err := getNumberGen[int64](tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -327,7 +459,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberGen[int8](tt.args.key)
+			got, err := getNumberGen[int8](tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -407,7 +539,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberGen[int16](tt.args.key)
+			got, err := getNumberGen[int16](tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -487,7 +619,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberGen[int32](tt.args.key)
+			got, err := getNumberGen[int32](tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -567,7 +699,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberGen[float32](tt.args.key)
+			got, err := getNumberGen[float32](tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -647,7 +779,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberGen[float64](tt.args.key)
+			got, err := getNumberGen[float64](tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -731,7 +863,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getBool(tt.args.key)
+			got, err := getBool(tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -823,7 +955,7 @@ type expected struct {
 			},
 			expected: expected{
 				val:     nil,
-				wantErr: errorEqual(t, ErrInvalidValue),
+				wantErr: errorEqual(t, ErrMissingSeparator),
 			},
 		},
 		{
@@ -848,7 +980,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getStringSlice(tt.args.key, tt.args.sep)
+			got, err := getStringSlice(tt.args.key, Parameters{Separator: tt.args.sep})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -923,7 +1055,7 @@ type expected struct {
 			},
 			expected: expected{
 				val:     nil,
-				wantErr: errorEqual(t, ErrInvalidValue),
+				wantErr: errorEqual(t, ErrMissingSeparator),
 			},
 		},
 		{
@@ -965,7 +1097,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberSliceGen[int](tt.args.key, tt.args.sep)
+			got, err := getNumberSliceGen[int](tt.args.key, Parameters{Separator: tt.args.sep})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -1040,7 +1172,7 @@ type expected struct {
 			},
 			expected: expected{
 				val:     nil,
-				wantErr: errorEqual(t, ErrInvalidValue),
+				wantErr: errorEqual(t, ErrMissingSeparator),
 			},
 		},
 		{
@@ -1100,7 +1232,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberSliceGen[float32](tt.args.key, tt.args.sep)
+			got, err := getNumberSliceGen[float32](tt.args.key, Parameters{Separator: tt.args.sep})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -1175,7 +1307,7 @@ type expected struct {
 			},
 			expected: expected{
 				val:     nil,
-				wantErr: errorEqual(t, ErrInvalidValue),
+				wantErr: errorEqual(t, ErrMissingSeparator),
 			},
 		},
 		{
@@ -1235,7 +1367,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberSliceGen[float64](tt.args.key, tt.args.sep)
+			got, err := getNumberSliceGen[float64](tt.args.key, Parameters{Separator: tt.args.sep})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -1310,7 +1442,7 @@ type expected struct {
 			},
 			expected: expected{
 				val:     nil,
-				wantErr: errorEqual(t, ErrInvalidValue),
+				wantErr: errorEqual(t, ErrMissingSeparator),
 			},
 		},
 		{
@@ -1370,7 +1502,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberSliceGen[int16](tt.args.key, tt.args.sep)
+			got, err := getNumberSliceGen[int16](tt.args.key, Parameters{Separator: tt.args.sep})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -1445,7 +1577,7 @@ type expected struct {
 			},
 			expected: expected{
 				val:     nil,
-				wantErr: errorEqual(t, ErrInvalidValue),
+				wantErr: errorEqual(t, ErrMissingSeparator),
 			},
 		},
 		{
@@ -1505,7 +1637,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberSliceGen[int32](tt.args.key, tt.args.sep)
+			got, err := getNumberSliceGen[int32](tt.args.key, Parameters{Separator: tt.args.sep})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -1580,7 +1712,7 @@ type expected struct {
 			},
 			expected: expected{
 				val:     nil,
-				wantErr: errorEqual(t, ErrInvalidValue),
+				wantErr: errorEqual(t, ErrMissingSeparator),
 			},
 		},
 		{
@@ -1640,7 +1772,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberSliceGen[uint](tt.args.key, tt.args.sep)
+			got, err := getNumberSliceGen[uint](tt.args.key, Parameters{Separator: tt.args.sep})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -1715,7 +1847,7 @@ type expected struct {
 			},
 			expected: expected{
 				val:     nil,
-				wantErr: errorEqual(t, ErrInvalidValue),
+				wantErr: errorEqual(t, ErrMissingSeparator),
 			},
 		},
 		{
@@ -1775,7 +1907,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberSliceGen[uint8](tt.args.key, tt.args.sep)
+			got, err := getNumberSliceGen[uint8](tt.args.key, Parameters{Separator: tt.args.sep})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -1850,7 +1982,7 @@ type expected struct {
 			},
 			expected: expected{
 				val:     nil,
-				wantErr: errorEqual(t, ErrInvalidValue),
+				wantErr: errorEqual(t, ErrMissingSeparator),
 			},
 		},
 		{
@@ -1910,7 +2042,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberSliceGen[uint16](tt.args.key, tt.args.sep)
+			got, err := getNumberSliceGen[uint16](tt.args.key, Parameters{Separator: tt.args.sep})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -1985,7 +2117,7 @@ type expected struct {
 			},
 			expected: expected{
 				val:     nil,
-				wantErr: errorEqual(t, ErrInvalidValue),
+				wantErr: errorEqual(t, ErrMissingSeparator),
 			},
 		},
 		{
@@ -2045,7 +2177,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberSliceGen[uint32](tt.args.key, tt.args.sep)
+			got, err := getNumberSliceGen[uint32](tt.args.key, Parameters{Separator: tt.args.sep})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -2120,7 +2252,7 @@ type expected struct {
 			},
 			expected: expected{
 				val:     nil,
-				wantErr: errorEqual(t, ErrInvalidValue),
+				wantErr: errorEqual(t, ErrMissingSeparator),
 			},
 		},
 		{
@@ -2180,7 +2312,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberSliceGen[int8](tt.args.key, tt.args.sep)
+			got, err := getNumberSliceGen[int8](tt.args.key, Parameters{Separator: tt.args.sep})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -2255,7 +2387,7 @@ type expected struct {
 			},
 			expected: expected{
 				val:     nil,
-				wantErr: errorEqual(t, ErrInvalidValue),
+				wantErr: errorEqual(t, ErrMissingSeparator),
 			},
 		},
 		{
@@ -2315,7 +2447,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberSliceGen[int64](tt.args.key, tt.args.sep)
+			got, err := getNumberSliceGen[int64](tt.args.key, Parameters{Separator: tt.args.sep})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -2418,7 +2550,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getTime(tt.args.key, tt.args.layout)
+			got, err := getTime(tt.args.key, Parameters{Layout: tt.args.layout})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -2514,7 +2646,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getURL(tt.args.key)
+			got, err := getURL(tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -2625,7 +2757,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getTimeSlice(tt.args.key, tt.args.layout, tt.args.separator)
+			got, err := getTimeSlice(tt.args.key, Parameters{Layout: tt.args.layout, Separator: tt.args.separator})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -2728,7 +2860,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getDurationSlice(tt.args.key, tt.args.separator)
+			got, err := getDurationSlice(tt.args.key, Parameters{Separator: tt.args.separator})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -2824,7 +2956,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getDuration(tt.args.key)
+			got, err := getDuration(tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -2920,7 +3052,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberGen[uint64](tt.args.key)
+			got, err := getNumberGen[uint64](tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -2995,7 +3127,7 @@ type expected struct {
 			},
 			expected: expected{
 				val:     nil,
-				wantErr: errorEqual(t, ErrInvalidValue),
+				wantErr: errorEqual(t, ErrMissingSeparator),
 			},
 		},
 		{
@@ -3038,7 +3170,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberSliceGen[uint64](tt.args.key, tt.args.sep)
+			got, err := getNumberSliceGen[uint64](tt.args.key, Parameters{Separator: tt.args.sep})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -3134,7 +3266,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberGen[uint8](tt.args.key)
+			got, err := getNumberGen[uint8](tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -3230,7 +3362,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberGen[uint](tt.args.key)
+			got, err := getNumberGen[uint](tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -3326,7 +3458,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberGen[uint16](tt.args.key)
+			got, err := getNumberGen[uint16](tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -3422,7 +3554,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberGen[uint32](tt.args.key)
+			got, err := getNumberGen[uint32](tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -3534,7 +3666,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getIP(tt.args.key)
+			got, err := getIP(tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -3630,7 +3762,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNetIPAddr(tt.args.key)
+			got, err := getNetIPAddr(tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -3724,7 +3856,7 @@ type expected struct {
 			},
 			expected: expected{
 				val:     nil,
-				wantErr: errorEqual(t, ErrInvalidValue),
+				wantErr: errorEqual(t, ErrMissingSeparator),
 			},
 		},
 	}
@@ -3733,7 +3865,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNetIPAddrSlice(tt.args.key, tt.args.separator)
+			got, err := getNetIPAddrSlice(tt.args.key, Parameters{Separator: tt.args.separator})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -3829,7 +3961,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNetIPPrefix(tt.args.key)
+			got, err := getNetIPPrefix(tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -3923,7 +4055,7 @@ type expected struct {
 			},
 			expected: expected{
 				val:     nil,
-				wantErr: errorEqual(t, ErrInvalidValue),
+				wantErr: errorEqual(t, ErrMissingSeparator),
 			},
 		},
 	}
@@ -3932,7 +4064,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNetIPPrefixSlice(tt.args.key, tt.args.separator)
+			got, err := getNetIPPrefixSlice(tt.args.key, Parameters{Separator: tt.args.separator})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -4028,7 +4160,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getHardwareAddr(tt.args.key)
+			got, err := getHardwareAddr(tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -4122,7 +4254,7 @@ type expected struct {
 			},
 			expected: expected{
 				val:     nil,
-				wantErr: errorEqual(t, ErrInvalidValue),
+				wantErr: errorEqual(t, ErrMissingSeparator),
 			},
 		},
 	}
@@ -4131,7 +4263,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getHardwareAddrSlice(tt.args.key, tt.args.separator)
+			got, err := getHardwareAddrSlice(tt.args.key, Parameters{Separator: tt.args.separator})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -4235,7 +4367,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getURLSlice(tt.args.key, tt.args.separator)
+			got, err := getURLSlice(tt.args.key, Parameters{Separator: tt.args.separator})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -4339,7 +4471,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getIPSlice(tt.args.key, tt.args.separator)
+			got, err := getIPSlice(tt.args.key, Parameters{Separator: tt.args.separator})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -4458,7 +4590,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getBoolSlice(tt.args.key, tt.args.separator)
+			got, err := getBoolSlice(tt.args.key, Parameters{Separator: tt.args.separator})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -4554,7 +4686,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberGen[uintptr](tt.args.key)
+			got, err := getNumberGen[uintptr](tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -4656,7 +4788,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getNumberSliceGen[uintptr](tt.args.key, tt.args.separator)
+			got, err := getNumberSliceGen[uintptr](tt.args.key, Parameters{Separator: tt.args.separator})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -4752,7 +4884,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getComplexGen[complex64](tt.args.key)
+			got, err := getComplexGen[complex64](tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -4854,7 +4986,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getComplexSliceGen[complex64](tt.args.key, tt.args.separator)
+			got, err := getComplexSliceGen[complex64](tt.args.key, Parameters{Separator: tt.args.separator})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -4950,7 +5082,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getComplexGen[complex128](tt.args.key)
+			got, err := getComplexGen[complex128](tt.args.key, Parameters{})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -5052,7 +5184,7 @@ type expected struct {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.precond.maybeSetEnv(t, tt.args.key)
 
-			got, err := getComplexSliceGen[complex128](tt.args.key, tt.args.separator)
+			got, err := getComplexSliceGen[complex128](tt.args.key, Parameters{Separator: tt.args.separator})
 			if !tt.expected.wantErr(t, err) {
 				return
 			}
@@ -5061,3 +5193,283 @@ type expected struct {
 		})
 	}
 }
+
+func Test_getNumberGenBase(t *testing.T) {
+	src := mapSource{
+		"PERM":       "0644",
+		"MASK":       "0xFF00",
+		"FLAGS":      "0b1010",
+		"OCTAL":      "0o17",
+		"READABLE":   "1_000_000",
+		"HEX":        "ff",
+		"NEGATIVE":   "-0x10",
+		"HEX_SLICE":  "0x10,0b11,7",
+		"FLOAT":      "10.5",
+		"OVERFLOW":   "0x1FF",
+		"UNDERSCORE": "1_0",
+	}
+
+	auto := Parameters{
+		Source:    src,
+		AutoBase:  true,
+		Separator: ",",
+	}
+
+	tests := []struct {
+		name    string
+		parse   func() (any, error)
+		want    any
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "auto octal without prefix letter",
+			parse:   func() (any, error) { return getNumberGen[uint32]("PERM", auto) },
+			want:    uint32(0o644),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "auto hex",
+			parse:   func() (any, error) { return getNumberGen[uint16]("MASK", auto) },
+			want:    uint16(0xFF00),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "auto binary",
+			parse:   func() (any, error) { return getNumberGen[int]("FLAGS", auto) },
+			want:    0b1010,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "auto octal",
+			parse:   func() (any, error) { return getNumberGen[int8]("OCTAL", auto) },
+			want:    int8(0o17),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "auto underscores",
+			parse:   func() (any, error) { return getNumberGen[int64]("READABLE", auto) },
+			want:    int64(1_000_000),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "auto negative",
+			parse:   func() (any, error) { return getNumberGen[int]("NEGATIVE", auto) },
+			want:    -16,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "auto slice",
+			parse:   func() (any, error) { return getNumberSliceGen[uint]("HEX_SLICE", auto) },
+			want:    []uint{16, 3, 7},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "explicit base",
+			parse:   func() (any, error) { return getNumberGen[uint8]("HEX", Parameters{Source: src, Base: 16}) },
+			want:    uint8(0xff),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "auto overflow",
+			parse:   func() (any, error) { return getNumberGen[uint8]("OVERFLOW", auto) },
+			want:    uint8(0),
+			wantErr: errorEqual(t, ErrOutOfRange),
+		},
+		{
+			name:    "underscores require auto base",
+			parse:   func() (any, error) { return getNumberGen[int]("UNDERSCORE", Parameters{Source: src}) },
+			want:    0,
+			wantErr: errorEqual(t, ErrSyntax),
+		},
+		{
+			name:    "floats ignore base",
+			parse:   func() (any, error) { return getNumberGen[float64]("FLOAT", auto) },
+			want:    10.5,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "invalid base",
+			parse:   func() (any, error) { return getNumberGen[int]("HEX", Parameters{Source: src, Base: 37}) },
+			want:    0,
+			wantErr: errorEqual(t, ErrInvalidBase),
+		},
+		{
+			name: "invalid base of slice",
+			parse: func() (any, error) {
+				return getNumberSliceGen[uint]("HEX_SLICE", Parameters{Source: src, Base: -1, Separator: ","})
+			},
+			want:    []uint(nil),
+			wantErr: errorEqual(t, ErrInvalidBase),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse()
+			if !tt.wantErr(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_emptyElements(t *testing.T) {
+	src := mapSource{
+		"STRINGS": " a, ,b ,, c",
+		"INTS":    "1, 2,,3",
+		"BLANK":   " , ",
+		"NESTED":  "1, 2;;3 ,4,",
+		"MAP":     "a = 1,, b=2",
+		"PAIRS":   "a=1,,b=2",
+	}
+
+	tests := []struct {
+		name    string
+		key     string
+		zero    any
+		params  Parameters
+		want    any
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "strings kept as is",
+			key:     "STRINGS",
+			zero:    []string(nil),
+			params:  Parameters{},
+			want:    []string{" a", " ", "b ", "", " c"},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "strings trimmed",
+			key:     "STRINGS",
+			zero:    []string(nil),
+			params:  Parameters{TrimSpace: true},
+			want:    []string{"a", "", "b", "", "c"},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "strings trimmed and dropped",
+			key:     "STRINGS",
+			zero:    []string(nil),
+			params:  Parameters{TrimSpace: true, EmptyElements: EmptyDrop},
+			want:    []string{"a", "b", "c"},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "white space is not empty without trimming",
+			key:     "STRINGS",
+			zero:    []string(nil),
+			params:  Parameters{EmptyElements: EmptyDrop},
+			want:    []string{" a", " ", "b ", " c"},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "empty strings rejected",
+			key:     "STRINGS",
+			zero:    []string(nil),
+			params:  Parameters{TrimSpace: true, EmptyElements: EmptyReject},
+			wantErr: errorKind(t, ErrEmptyElement),
+		},
+		{
+			name:    "all dropped",
+			key:     "BLANK",
+			zero:    []string(nil),
+			params:  Parameters{TrimSpace: true, EmptyElements: EmptyDrop},
+			want:    []string{},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "empty number kept",
+			key:     "INTS",
+			zero:    []int(nil),
+			params:  Parameters{TrimSpace: true},
+			wantErr: errorKind(t, ErrEmptyElement),
+		},
+		{
+			name:    "empty number dropped",
+			key:     "INTS",
+			zero:    []int(nil),
+			params:  Parameters{TrimSpace: true, EmptyElements: EmptyDrop},
+			want:    []int{1, 2, 3},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "number not trimmed",
+			key:     "INTS",
+			zero:    []int(nil),
+			params:  Parameters{EmptyElements: EmptyDrop},
+			wantErr: errorKind(t, ErrSyntax),
+		},
+		{
+			name:    "nested dropped",
+			key:     "NESTED",
+			zero:    [][]int(nil),
+			params:  Parameters{OuterSeparator: ";", TrimSpace: true, EmptyElements: EmptyDrop},
+			want:    [][]int{{1, 2}, {3, 4}},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "nested empty row rejected",
+			key:     "NESTED",
+			zero:    [][]int(nil),
+			params:  Parameters{OuterSeparator: ";", TrimSpace: true, EmptyElements: EmptyReject},
+			wantErr: errorKind(t, ErrEmptyElement),
+		},
+		{
+			name:    "map empty pair kept",
+			key:     "PAIRS",
+			zero:    map[string]int(nil),
+			params:  Parameters{KeyValueSeparator: "="},
+			wantErr: errorKind(t, ErrEmptyElement),
+		},
+		{
+			name:    "map empty pair dropped",
+			key:     "PAIRS",
+			zero:    map[string]int(nil),
+			params:  Parameters{KeyValueSeparator: "=", EmptyElements: EmptyDrop},
+			want:    map[string]int{"a": 1, "b": 2},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "map empty pair rejected",
+			key:     "PAIRS",
+			zero:    map[string]int(nil),
+			params:  Parameters{KeyValueSeparator: "=", EmptyElements: EmptyReject},
+			wantErr: errorKind(t, ErrEmptyElement),
+		},
+		{
+			name:    "map trimmed and dropped",
+			key:     "MAP",
+			zero:    map[string]int(nil),
+			params:  Parameters{KeyValueSeparator: "=", TrimSpace: true, EmptyElements: EmptyDrop},
+			want:    map[string]int{"a": 1, "b": 2},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "map trimmed empty pair rejected",
+			key:     "MAP",
+			zero:    map[string]int(nil),
+			params:  Parameters{KeyValueSeparator: "=", TrimSpace: true, EmptyElements: EmptyReject},
+			wantErr: errorKind(t, ErrEmptyElement),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, ok := LookupEnvParser(tt.zero)
+			require.True(t, ok)
+
+			tt.params.Source = src
+			tt.params.Separator = ","
+
+			got, err := p.ParseEnv(tt.key, tt.params)
+			if !tt.wantErr(t, err) || err != nil {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package internal
+
+import (
+	"errors"
+	"reflect"
+)
+
+// lookupPointerParser returns EnvParser for pointer to the supported non-pointer type.
+func lookupPointerParser(t reflect.Type) (EnvParser, bool) {
+	if t == nil || t.Kind() != reflect.Pointer || t.Elem().Kind() == reflect.Pointer {
+		return nil, false
+	}
+
+	inner, ok := LookupEnvParser(reflect.Zero(t.Elem()).Interface())
+	if !ok {
+		return nil, false
+	}
+
+	return pointerParser{
+		elem:  t.Elem(),
+		inner: inner,
+	}, true
+}
+
+// pointerParser is a parser for pointer types.
+// It returns nil pointer when the variable is not set and pointer to the parsed value otherwise.
+type pointerParser struct {
+	elem  reflect.Type
+	inner EnvParser
+}
+
+func (p pointerParser) ParseEnv(key string, options Parameters) (any, error) {
+	val, err := p.inner.ParseEnv(key, options)
+	if err != nil {
+		if errors.Is(err, ErrNotSet) {
+			return reflect.Zero(reflect.PointerTo(p.elem)).Interface(), nil
+		}
+
+		return nil, err
+	}
+
+	ptr := reflect.New(p.elem)
+	ptr.Elem().Set(reflect.ValueOf(val))
+
+	return ptr.Interface(), nil
+}
@@ -0,0 +1,45 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_pointerParser(t *testing.T) {
+	src := mapSource{
+		"INT":      "42",
+		"DURATION": "1m",
+		"INTS":     "1,2",
+		"INVALID":  "nope",
+	}
+
+	params := Parameters{
+		Source:    src,
+		Separator: ",",
+	}
+
+	got, err := NewEnvParser((*int)(nil)).ParseEnv("INT", params)
+	require.NoError(t, err)
+	require.IsType(t, (*int)(nil), got)
+	assert.Equal(t, 42, *got.(*int))
+
+	got, err = NewEnvParser((*time.Duration)(nil)).ParseEnv("DURATION", params)
+	require.NoError(t, err)
+	require.IsType(t, (*time.Duration)(nil), got)
+	assert.Equal(t, time.Minute, *got.(*time.Duration))
+
+	got, err = NewEnvParser((*[]int)(nil)).ParseEnv("INTS", params)
+	require.NoError(t, err)
+	require.IsType(t, (*[]int)(nil), got)
+	assert.Equal(t, []int{1, 2}, *got.(*[]int))
+
+	got, err = NewEnvParser((*int)(nil)).ParseEnv("MISSING", params)
+	require.NoError(t, err)
+	assert.Equal(t, (*int)(nil), got)
+
+	_, err = NewEnvParser((*int)(nil)).ParseEnv("INVALID", params)
+	assert.ErrorIs(t, err, ErrInvalidValue)
+}
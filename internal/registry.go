@@ -0,0 +1,118 @@
+package internal
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// CustomParseFunc parses the raw value of environment variable into a value of the registered type.
+type CustomParseFunc func(raw string, options Parameters) (any, error)
+
+// registry holds parsers of the user-defined types.
+var registry = struct {
+	sync.RWMutex
+	parsers map[reflect.Type]CustomParseFunc
+}{
+	parsers: make(map[reflect.Type]CustomParseFunc),
+}
+
+// RegisterParser registers parser for the type t.
+// The registered parser is also used for the elements of []t.
+// It panics if fn is nil or t is a built-in supported type.
+func RegisterParser(t reflect.Type, fn CustomParseFunc) {
+	if fn == nil {
+		panic(fmt.Sprintf("nil parser for type %s", t))
+	}
+
+	if t == nil || newBuiltinParser(reflect.Zero(t).Interface()) != nil {
+		panic(fmt.Sprintf("type %s is already supported", t))
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	registry.parsers[t] = fn
+}
+
+// lookupCustomParser returns EnvParser for the registered type or slice of the registered type.
+func lookupCustomParser(t reflect.Type) (EnvParser, bool) {
+	if t == nil {
+		return nil, false
+	}
+
+	registry.RLock()
+	defer registry.RUnlock()
+
+	if fn, ok := registry.parsers[t]; ok {
+		return customParser{
+			fn: fn,
+		}, true
+	}
+
+	if t.Kind() == reflect.Slice {
+		if fn, ok := registry.parsers[t.Elem()]; ok {
+			return customSliceParser{
+				elem: t.Elem(),
+				fn:   fn,
+			}, true
+		}
+	}
+
+	return nil, false
+}
+
+// customParser is a parser for the registered type.
+type customParser struct {
+	fn CustomParseFunc
+}
+
+func (c customParser) ParseEnv(key string, options Parameters) (any, error) {
+	env, err := getString(key, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseCustom(c.fn, env, options)
+}
+
+// customSliceParser is a parser for slice of the registered type.
+type customSliceParser struct {
+	elem reflect.Type
+	fn   CustomParseFunc
+}
+
+func (c customSliceParser) ParseEnv(key string, options Parameters) (any, error) {
+	env, err := getStringSlice(key, options)
+	if err != nil {
+		return nil, err
+	}
+
+	val := reflect.MakeSlice(reflect.SliceOf(c.elem), 0, len(env))
+
+	for i, s := range env {
+		v, err := parseCustom(c.fn, s, options)
+		if err != nil {
+			return nil, newElementError(i, s, err)
+		}
+
+		rv := reflect.Zero(c.elem)
+		if v != nil {
+			rv = reflect.ValueOf(v)
+		}
+
+		val = reflect.Append(val, rv)
+	}
+
+	return val.Interface(), nil
+}
+
+// parseCustom calls the registered parser, reporting its errors as invalid values.
+func parseCustom(fn CustomParseFunc, raw string, options Parameters) (any, error) {
+	v, err := fn(raw, options)
+	if err != nil {
+		return nil, wrapErrInvalidValue(err)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// registryTestLevel is a user-defined type for registry tests.
+type registryTestLevel int
+
+var errRegistryTestLevel = errors.New("unknown level")
+
+func parseRegistryTestLevel(raw string, _ Parameters) (any, error) {
+	switch strings.ToLower(raw) {
+	case "debug":
+		return registryTestLevel(0), nil
+	case "info":
+		return registryTestLevel(1), nil
+	default:
+		return nil, errRegistryTestLevel
+	}
+}
+
+func TestRegisterParser(t *testing.T) {
+	RegisterParser(reflect.TypeFor[registryTestLevel](), parseRegistryTestLevel)
+
+	p, ok := LookupEnvParser(registryTestLevel(0))
+	require.True(t, ok)
+	assert.IsType(t, customParser{}, p)
+
+	p, ok = LookupEnvParser([]registryTestLevel{})
+	require.True(t, ok)
+	assert.IsType(t, customSliceParser{}, p)
+
+	_, ok = LookupEnvParser([][][]registryTestLevel{})
+	assert.False(t, ok)
+
+	src := mapSource{
+		"LEVEL":         "INFO",
+		"LEVELS":        "debug,info",
+		"INVALID_LEVEL": "trace",
+	}
+
+	got, err := NewEnvParser(registryTestLevel(0)).ParseEnv("LEVEL", Parameters{Source: src})
+	require.NoError(t, err)
+	assert.Equal(t, registryTestLevel(1), got)
+
+	got, err = NewEnvParser([]registryTestLevel{}).ParseEnv("LEVELS", Parameters{Source: src, Separator: ","})
+	require.NoError(t, err)
+	assert.Equal(t, []registryTestLevel{0, 1}, got)
+
+	_, err = NewEnvParser(registryTestLevel(0)).ParseEnv("INVALID_LEVEL", Parameters{Source: src})
+	assert.ErrorIs(t, err, ErrInvalidValue)
+	assert.ErrorIs(t, err, errRegistryTestLevel)
+
+	_, err = NewEnvParser([]registryTestLevel{}).ParseEnv("LEVELS", Parameters{Source: src})
+	assert.ErrorIs(t, err, ErrMissingSeparator)
+
+	_, err = NewEnvParser(registryTestLevel(0)).ParseEnv("MISSING", Parameters{Source: src})
+	errorEqual(t, ErrNotSet)(t, err)
+}
+
+func TestRegisterParserPanics(t *testing.T) {
+	assert.Panics(t, func() {
+		RegisterParser(reflect.TypeFor[int](), parseRegistryTestLevel)
+	})
+
+	assert.Panics(t, func() {
+		RegisterParser(reflect.TypeFor[registryTestLevel](), nil)
+	})
+
+	assert.Panics(t, func() {
+		RegisterParser(nil, parseRegistryTestLevel)
+	})
+}
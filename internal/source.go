@@ -0,0 +1,47 @@
+package internal
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// fileKeySuffix is appended to the key to get the name of the variable holding the path to the value file.
+const fileKeySuffix = "_FILE"
+
+// Source is a contract for looking up raw values of environment variables.
+type Source interface {
+	// Lookup retrieves the value of the variable named by the key.
+	// The boolean result reports whether the variable is present.
+	Lookup(key string) (string, bool)
+}
+
+// envSource is a Source backed by the process environment.
+type envSource struct{}
+
+func (envSource) Lookup(key string) (string, bool) {
+	return os.LookupEnv(key)
+}
+
+// lookupFile implements the KEY_FILE convention: when KEY is not set and KEY_FILE is,
+// the value is read from the file KEY_FILE points at, with surrounding whitespace trimmed.
+// It is an error to set both KEY and KEY_FILE.
+func lookupFile(key, env string, isSet bool, p Parameters) (string, bool, error) {
+	fileKey := key + fileKeySuffix
+
+	path, ok := p.lookup(fileKey)
+	if !ok || path == "" {
+		return env, isSet, nil
+	}
+
+	if isSet {
+		return "", false, newErrInvalidValue(fmt.Sprintf("both %q and %q are set", key, fileKey))
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", false, newErrInvalidValue(fmt.Sprintf("failed to read %q: %v", fileKey, err))
+	}
+
+	return strings.TrimSpace(string(content)), true, nil
+}
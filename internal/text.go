@@ -0,0 +1,105 @@
+package internal
+
+import (
+	"encoding"
+	"reflect"
+)
+
+// textUnmarshalerType is the reflect.Type of encoding.TextUnmarshaler.
+var textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+// NewTextParser is a constructor for the parser of type t whose pointer implements encoding.TextUnmarshaler.
+func NewTextParser(t reflect.Type) EnvParser {
+	return textParser{
+		typ: t,
+	}
+}
+
+// NewTextSliceParser is a constructor for the parser of slice of type elem
+// whose pointer implements encoding.TextUnmarshaler.
+func NewTextSliceParser(elem reflect.Type) EnvParser {
+	return textSliceParser{
+		elem: elem,
+	}
+}
+
+// lookupTextParser returns EnvParser for type whose pointer implements encoding.TextUnmarshaler or slice of it.
+func lookupTextParser(t reflect.Type) (EnvParser, bool) {
+	if t == nil {
+		return nil, false
+	}
+
+	if isTextUnmarshaler(t) {
+		return NewTextParser(t), true
+	}
+
+	if t.Kind() == reflect.Slice && isTextUnmarshaler(t.Elem()) {
+		return NewTextSliceParser(t.Elem()), true
+	}
+
+	return nil, false
+}
+
+func isTextUnmarshaler(t reflect.Type) bool {
+	return reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+// textParser is a parser for encoding.TextUnmarshaler types.
+type textParser struct {
+	typ reflect.Type
+}
+
+func (t textParser) ParseEnv(key string, options Parameters) (any, error) {
+	env, err := getString(key, options)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := parseText(t.typ, env)
+	if err != nil {
+		return nil, err
+	}
+
+	return val.Interface(), nil
+}
+
+// textSliceParser is a parser for slice of encoding.TextUnmarshaler types.
+type textSliceParser struct {
+	elem reflect.Type
+}
+
+func (t textSliceParser) ParseEnv(key string, options Parameters) (any, error) {
+	env, err := getStringSlice(key, options)
+	if err != nil {
+		return nil, err
+	}
+
+	val := reflect.MakeSlice(reflect.SliceOf(t.elem), 0, len(env))
+
+	for i, s := range env {
+		v, err := parseText(t.elem, s)
+		if err != nil {
+			return nil, newElementError(i, s, err)
+		}
+
+		val = reflect.Append(val, v)
+	}
+
+	return val.Interface(), nil
+}
+
+// parseText unmarshals raw value into a new value of type t.
+func parseText(t reflect.Type, raw string) (reflect.Value, error) {
+	ptr := reflect.New(t)
+
+	u, ok := ptr.Interface().(encoding.TextUnmarshaler)
+	if !ok {
+		return reflect.Value{}, ErrInvalidValue
+	}
+
+	if err := u.UnmarshalText([]byte(raw)); err != nil {
+		return reflect.Value{}, wrapErrInvalidValue(err)
+	}
+
+	return ptr.Elem(), nil
+}
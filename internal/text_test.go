@@ -0,0 +1,51 @@
+package internal
+
+import (
+	"log/slog"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_lookupTextParser(t *testing.T) {
+	p, ok := LookupEnvParser(slog.Level(0))
+	require.True(t, ok)
+	assert.IsType(t, textParser{}, p)
+
+	p, ok = LookupEnvParser([]big.Int{})
+	require.True(t, ok)
+	assert.IsType(t, textSliceParser{}, p)
+
+	_, ok = LookupEnvParser([][][]slog.Level{})
+	assert.False(t, ok)
+
+	_, ok = lookupTextParser(nil)
+	assert.False(t, ok)
+}
+
+func Test_textParser(t *testing.T) {
+	src := mapSource{
+		"LEVEL":   "INFO+2",
+		"LEVELS":  "debug;error",
+		"INVALID": "nope",
+	}
+
+	got, err := NewEnvParser(slog.Level(0)).ParseEnv("LEVEL", Parameters{Source: src})
+	require.NoError(t, err)
+	assert.Equal(t, slog.LevelInfo+2, got)
+
+	got, err = NewEnvParser([]slog.Level{}).ParseEnv("LEVELS", Parameters{Source: src, Separator: ";"})
+	require.NoError(t, err)
+	assert.Equal(t, []slog.Level{slog.LevelDebug, slog.LevelError}, got)
+
+	_, err = NewEnvParser(slog.Level(0)).ParseEnv("INVALID", Parameters{Source: src})
+	assert.ErrorIs(t, err, ErrInvalidValue)
+
+	_, err = NewEnvParser([]slog.Level{}).ParseEnv("INVALID", Parameters{Source: src, Separator: ";"})
+	assert.ErrorIs(t, err, ErrInvalidValue)
+
+	_, err = NewEnvParser(slog.Level(0)).ParseEnv("MISSING", Parameters{Source: src})
+	errorEqual(t, ErrNotSet)(t, err)
+}
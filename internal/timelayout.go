@@ -0,0 +1,75 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AutoLayouts are the time layouts tried in order by the automatic layout detection.
+// RFC3339 accepts fractional seconds, so it matches RFC3339Nano values as well.
+var AutoLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	time.DateTime,
+	time.DateOnly,
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC850,
+	time.RFC822Z,
+	time.RFC822,
+	time.RubyDate,
+	time.UnixDate,
+	time.ANSIC,
+}
+
+// timeLayouts returns the time layouts to try in order, Layout takes precedence over Layouts.
+func (p Parameters) timeLayouts() []string {
+	if p.Layout != "" {
+		return []string{p.Layout}
+	}
+
+	return p.Layouts
+}
+
+// parseTimeLayout parses raw with the layout in Parameters.Location with time.ParseInLocation,
+// or with time.Parse when the location is not set.
+func (p Parameters) parseTimeLayout(layout, raw string) (time.Time, error) {
+	if p.Location == nil {
+		return time.Parse(layout, raw)
+	}
+
+	return time.ParseInLocation(layout, raw, p.Location)
+}
+
+// parseTime parses raw with the first matching layout, see parseTimeLayout.
+// When none matches, the error of a single layout is returned as is, otherwise all the layouts are reported.
+func parseTime(raw string, p Parameters) (time.Time, error) {
+	layouts := p.timeLayouts()
+
+	switch len(layouts) {
+	case 0:
+		return time.Time{}, ErrMissingLayout
+	case 1:
+		val, err := p.parseTimeLayout(layouts[0], raw)
+		if err != nil {
+			return time.Time{}, wrapErrSyntax(err)
+		}
+
+		return val, nil
+	}
+
+	for _, layout := range layouts {
+		if val, err := p.parseTimeLayout(layout, raw); err == nil {
+			return val, nil
+		}
+	}
+
+	quoted := make([]string, 0, len(layouts))
+
+	for _, layout := range layouts {
+		quoted = append(quoted, fmt.Sprintf("%q", layout))
+	}
+
+	return time.Time{}, wrapErrSyntax(fmt.Errorf("%q does not match any of the layouts %s", raw, strings.Join(quoted, ", ")))
+}
@@ -0,0 +1,138 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseTime(t *testing.T) {
+	date := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+	moment := time.Date(2024, time.March, 5, 14, 30, 15, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		raw     string
+		params  Parameters
+		want    time.Time
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "single layout",
+			raw:     "2024-03-05",
+			params:  Parameters{Layout: time.DateOnly},
+			want:    date,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "layout takes precedence",
+			raw:     "2024-03-05",
+			params:  Parameters{Layout: time.DateOnly, Layouts: []string{time.RFC3339}},
+			want:    date,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "second layout matches",
+			raw:     "2024-03-05 14:30:15",
+			params:  Parameters{Layouts: []string{time.DateOnly, time.DateTime}},
+			want:    moment,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "auto RFC3339",
+			raw:     "2024-03-05T14:30:15Z",
+			params:  Parameters{Layouts: AutoLayouts},
+			want:    moment,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "auto RFC3339Nano",
+			raw:     "2024-03-05T14:30:15.000000123Z",
+			params:  Parameters{Layouts: AutoLayouts},
+			want:    moment.Add(123 * time.Nanosecond),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "auto date only",
+			raw:     "2024-03-05",
+			params:  Parameters{Layouts: AutoLayouts},
+			want:    date,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "auto RFC1123",
+			raw:     "Tue, 05 Mar 2024 14:30:15 UTC",
+			params:  Parameters{Layouts: AutoLayouts},
+			want:    moment,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "wall time in location",
+			raw:     "2024-03-05 14:30:15",
+			params:  Parameters{Layout: time.DateTime, Location: time.FixedZone("CET", 3600)},
+			want:    moment.Add(-time.Hour),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "time zone kept",
+			raw:     "2024-03-05T14:30:15Z",
+			params:  Parameters{Layouts: AutoLayouts, Location: time.FixedZone("CET", 3600)},
+			want:    moment,
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "missing layout",
+			raw:     "2024-03-05",
+			params:  Parameters{},
+			wantErr: errorEqual(t, ErrMissingLayout),
+		},
+		{
+			name:   "no layout matches",
+			raw:    "05.03.2024",
+			params: Parameters{Layouts: []string{time.DateOnly, time.DateTime}},
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorIs(t, err, ErrSyntax, i...) &&
+					assert.ErrorIs(t, err, ErrInvalidValue, i...) &&
+					assert.ErrorContains(t, err,
+						`"05.03.2024" does not match any of the layouts "2006-01-02", "2006-01-02 15:04:05"`, i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTime(tt.raw, tt.params)
+			if !tt.wantErr(t, err) {
+				return
+			}
+
+			assert.True(t, tt.want.Equal(got), "want %s, got %s", tt.want, got)
+		})
+	}
+}
+
+func Test_parseTimeWithoutLocation(t *testing.T) {
+	// time.Parse returns the local location for the offset of the local time zone.
+	local := time.Local
+	time.Local = time.FixedZone("CET", 3600)
+
+	t.Cleanup(func() {
+		time.Local = local
+	})
+
+	raw := "2022-01-20T10:00:00+01:00"
+
+	want, err := time.Parse(time.RFC3339, raw)
+	require.NoError(t, err)
+
+	got, err := parseTime(raw, Parameters{Layout: time.RFC3339})
+	require.NoError(t, err)
+	assert.Equal(t, time.Local, got.Location())
+	assert.Equal(t, want, got)
+
+	got, err = parseTime(raw, Parameters{Layouts: AutoLayouts})
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,152 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// errPatternMismatch is the kind of the invalid value error reported by checkPattern.
+var errPatternMismatch = errors.New("pattern mismatch")
+
+// Allowed is a constraint for the types of Parameters.OneOf values.
+type Allowed interface {
+	string | Bound
+}
+
+// checkOneOfString reports the string that is not one of Parameters.OneOf with ErrNotAllowed.
+// It returns the allowed value as it is listed, which differs from s in case with Parameters.IgnoreCase.
+func checkOneOfString(s string, p Parameters) (string, error) {
+	if len(p.OneOf) == 0 {
+		return s, nil
+	}
+
+	for _, v := range p.OneOf {
+		allowed := fmt.Sprint(v)
+
+		if s == allowed || (p.IgnoreCase && strings.EqualFold(s, allowed)) {
+			return allowed, nil
+		}
+	}
+
+	return "", newErrNotAllowed(s, p.OneOf, suggest(s, p.OneOf))
+}
+
+// checkPattern reports the string that does not match Parameters.Pattern.
+func checkPattern(s string, p Parameters) error {
+	if p.Pattern == nil || p.Pattern.MatchString(s) {
+		return nil
+	}
+
+	return newInvalidValueError(fmt.Errorf("%q does not match pattern `%s`", s, p.Pattern), errPatternMismatch)
+}
+
+// isValidationError reports whether the error is reported by the validation options,
+// WithOneOf or WithPattern, rather than by the parser.
+func isValidationError(err error) bool {
+	return errors.Is(err, ErrNotAllowed) || errors.Is(err, errPatternMismatch)
+}
+
+// checkOneOfNumber reports the number that is not one of the numeric Parameters.OneOf with ErrNotAllowed.
+func checkOneOfNumber(v any, p Parameters) error {
+	if len(p.OneOf) == 0 {
+		return nil
+	}
+
+	for _, allowed := range p.OneOf {
+		if isNumber(allowed) && compareNumbers(v, allowed) == 0 {
+			return nil
+		}
+	}
+
+	return newErrNotAllowed(v, p.OneOf, "")
+}
+
+// isNumber reports whether v is of integer or float type.
+func isNumber(v any) bool {
+	rv := reflect.ValueOf(v)
+
+	return rv.CanInt() || rv.CanUint() || rv.CanFloat()
+}
+
+// newErrNotAllowed reports the value that is not one of the allowed values, with the suggestion if any.
+func newErrNotAllowed(v any, allowed []any, suggestion string) error {
+	list := make([]string, 0, len(allowed))
+
+	for _, a := range allowed {
+		list = append(list, formatAllowed(a))
+	}
+
+	msg := fmt.Sprintf("%s is not one of %s", formatAllowed(v), strings.Join(list, ", "))
+	if suggestion != "" {
+		msg += fmt.Sprintf(", did you mean %q?", suggestion)
+	}
+
+	return newInvalidValueError(fmt.Errorf("%s", msg), ErrNotAllowed)
+}
+
+// formatAllowed formats the value for the error message, strings are quoted.
+func formatAllowed(v any) string {
+	if s, ok := v.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+
+	return fmt.Sprint(v)
+}
+
+// suggest returns the allowed string closest to s, or empty string if none is close enough.
+// The distance is case-insensitive and must be at most half of the allowed string length.
+func suggest(s string, allowed []any) string {
+	var (
+		best     string
+		bestDist = -1
+	)
+
+	for _, v := range allowed {
+		a, ok := v.(string)
+		if !ok {
+			continue
+		}
+
+		d := levenshtein(strings.ToLower(s), strings.ToLower(a))
+		if d > len([]rune(a))/2 {
+			continue
+		}
+
+		if bestDist < 0 || d < bestDist {
+			best, bestDist = a, d
+		}
+	}
+
+	return best
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := range ra {
+		curr[0] = i + 1
+
+		for j := range rb {
+			cost := 1
+			if ra[i] == rb[j] {
+				cost = 0
+			}
+
+			curr[j+1] = min(prev[j+1]+1, curr[j]+1, prev[j]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
@@ -0,0 +1,229 @@
+package internal
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_checkOneOf(t *testing.T) {
+	src := mapSource{
+		"FORMAT":  "json",
+		"UPPER":   "JSON",
+		"TYPO":    "jsn",
+		"OTHER":   "xml",
+		"FORMATS": "json,Text",
+		"PORT":    "443",
+		"PORTS":   "80,8080",
+		"TIMEOUT": "5s",
+		"SIZE":    "2MiB",
+		"BLANKS":  "json,,text",
+	}
+
+	formats := []any{"json", "text"}
+
+	tests := []struct {
+		name    string
+		parse   func() (any, error)
+		want    any
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "allowed string",
+			parse: func() (any, error) {
+				return getStringValue("FORMAT", Parameters{Source: src, OneOf: formats})
+			},
+			want:    "json",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "case differs",
+			parse: func() (any, error) {
+				return getStringValue("UPPER", Parameters{Source: src, OneOf: formats})
+			},
+			want: "",
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorIs(t, err, ErrNotAllowed, i...) &&
+					assert.ErrorIs(t, err, ErrInvalidValue, i...) &&
+					assert.ErrorContains(t, err, `"JSON" is not one of "json", "text", did you mean "json"?`, i...)
+			},
+		},
+		{
+			name: "case folded to the listed value",
+			parse: func() (any, error) {
+				return getStringValue("UPPER", Parameters{Source: src, OneOf: formats, IgnoreCase: true})
+			},
+			want:    "json",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "typo suggestion",
+			parse: func() (any, error) {
+				return getStringValue("TYPO", Parameters{Source: src, OneOf: formats})
+			},
+			want: "",
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorIs(t, err, ErrNotAllowed, i...) &&
+					assert.ErrorContains(t, err, `did you mean "json"?`, i...)
+			},
+		},
+		{
+			name: "no suggestion",
+			parse: func() (any, error) {
+				return getStringValue("OTHER", Parameters{Source: src, OneOf: formats})
+			},
+			want: "",
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorIs(t, err, ErrNotAllowed, i...) &&
+					assert.NotContains(t, err.Error(), "did you mean", i...)
+			},
+		},
+		{
+			name: "string slice",
+			parse: func() (any, error) {
+				return getStringValueSlice("FORMATS", Parameters{Source: src, Separator: ",", OneOf: formats, IgnoreCase: true})
+			},
+			want:    []string{"json", "text"},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "string slice element not allowed",
+			parse: func() (any, error) {
+				return getStringValueSlice("FORMATS", Parameters{Source: src, Separator: ",", OneOf: formats})
+			},
+			want: []string(nil),
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorIs(t, err, ErrNotAllowed, i...) &&
+					assert.ErrorContains(t, err, `element [1] "Text"`, i...)
+			},
+		},
+		{
+			name: "empty string slice element not allowed",
+			parse: func() (any, error) {
+				return getStringValueSlice("BLANKS", Parameters{Source: src, Separator: ",", OneOf: formats})
+			},
+			want: []string(nil),
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorIs(t, err, ErrNotAllowed, i...) &&
+					assert.NotErrorIs(t, err, ErrEmptyElement, i...) &&
+					assert.ErrorContains(t, err, `element [1] ""`, i...)
+			},
+		},
+		{
+			name: "empty string slice element rejected",
+			parse: func() (any, error) {
+				return getStringValueSlice("BLANKS", Parameters{Source: src, Separator: ",", OneOf: formats, EmptyElements: EmptyReject})
+			},
+			want: []string(nil),
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorIs(t, err, ErrEmptyElement, i...)
+			},
+		},
+		{
+			name: "allowed number",
+			parse: func() (any, error) {
+				return getNumberGen[uint16]("PORT", Parameters{Source: src, OneOf: []any{80, 443}})
+			},
+			want:    uint16(443),
+			wantErr: assert.NoError,
+		},
+		{
+			name: "number slice element not allowed",
+			parse: func() (any, error) {
+				return getNumberSliceGen[int]("PORTS", Parameters{Source: src, Separator: ",", OneOf: []any{80, 443}})
+			},
+			want: []int(nil),
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorIs(t, err, ErrNotAllowed, i...) &&
+					assert.ErrorContains(t, err, "8080 is not one of 80, 443", i...)
+			},
+		},
+		{
+			name: "allowed duration",
+			parse: func() (any, error) {
+				return getDuration("TIMEOUT", Parameters{Source: src, OneOf: []any{time.Second, 5 * time.Second}})
+			},
+			want:    5 * time.Second,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "duration not allowed",
+			parse: func() (any, error) {
+				return getDuration("TIMEOUT", Parameters{Source: src, OneOf: []any{time.Second}})
+			},
+			want: time.Duration(0),
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorIs(t, err, ErrNotAllowed, i...) &&
+					assert.ErrorContains(t, err, "5s is not one of 1s", i...)
+			},
+		},
+		{
+			name: "byte size not allowed",
+			parse: func() (any, error) {
+				return getByteSize("SIZE", Parameters{Source: src, OneOf: []any{MiB}})
+			},
+			want: ByteSize(0),
+			wantErr: func(t assert.TestingT, err error, i ...any) bool {
+				return assert.ErrorIs(t, err, ErrNotAllowed, i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse()
+			if !tt.wantErr(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_levenshtein(t *testing.T) {
+	assert.Equal(t, 0, levenshtein("json", "json"))
+	assert.Equal(t, 1, levenshtein("jsn", "json"))
+	assert.Equal(t, 3, levenshtein("kitten", "sitting"))
+	assert.Equal(t, 4, levenshtein("", "text"))
+}
+
+func Test_checkPattern(t *testing.T) {
+	src := mapSource{
+		"BUCKET":  "my-bucket",
+		"INVALID": "My_Bucket",
+		"BUCKETS": "logs,Data",
+		"BLANKS":  "logs,,data",
+	}
+
+	re := regexp.MustCompile(`^[a-z0-9-]+$`)
+
+	got, err := getStringValue("BUCKET", Parameters{Source: src, Pattern: re})
+	require.NoError(t, err)
+	assert.Equal(t, "my-bucket", got)
+
+	_, err = getStringValue("INVALID", Parameters{Source: src, Pattern: re})
+	errorEqual(t, ErrInvalidValue)(t, err)
+	assert.ErrorContains(t, err, "\"My_Bucket\" does not match pattern `^[a-z0-9-]+$`")
+
+	_, err = getStringValueSlice("BUCKETS", Parameters{Source: src, Separator: ",", Pattern: re})
+	errorEqual(t, ErrInvalidValue)(t, err)
+	assert.ErrorContains(t, err, `element [1] "Data"`)
+
+	_, err = getStringValueSlice("BLANKS", Parameters{Source: src, Separator: ",", Pattern: re})
+	errorEqual(t, ErrInvalidValue)(t, err)
+	assert.NotErrorIs(t, err, ErrEmptyElement)
+	assert.ErrorContains(t, err, "\"\" does not match pattern")
+
+	m, err := NewEnvParser(map[string]string{}).ParseEnv("KEYS", Parameters{
+		Source:            mapSource{"KEYS": "A=b"},
+		Separator:         ",",
+		KeyValueSeparator: "=",
+		Pattern:           re,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"A": "b"}, m)
+}
@@ -0,0 +1,84 @@
+package getenv
+
+// Precedence defines which layer of LayeredSource wins when the key is present in several layers.
+type Precedence uint8
+
+const (
+	// FirstWins resolves the key from the first layer that has it.
+	FirstWins Precedence = iota
+	// LastWins resolves the key from the last layer that has it.
+	LastWins
+)
+
+// Layer is a named Source of LayeredSource.
+type Layer struct {
+	// Name identifies the layer in Origin results, e.g. "defaults", ".env" or "environment".
+	Name string
+	// Source is the source of the layer values.
+	Source Source
+}
+
+// LayeredSource is a Source that merges an ordered list of layers.
+// Layers with empty values are skipped, as empty values are treated as not set.
+//
+// Example:
+//
+//	src := getenv.NewLayeredSource(getenv.LastWins,
+//		getenv.Layer{Name: "defaults", Source: defaults},
+//		getenv.Layer{Name: ".env", Source: dotenvSource},
+//		getenv.Layer{Name: "environment", Source: getenv.OSEnv{}},
+//	)
+//
+//	port, err := getenv.Env[int]("PORT", option.WithSource(src))
+//
+//	origin, _ := src.Origin("PORT") // e.g. ".env"
+type LayeredSource struct {
+	precedence Precedence
+	layers     []Layer
+}
+
+// NewLayeredSource creates LayeredSource from the layers in the given order.
+func NewLayeredSource(precedence Precedence, layers ...Layer) *LayeredSource {
+	return &LayeredSource{
+		precedence: precedence,
+		layers:     append([]Layer(nil), layers...),
+	}
+}
+
+// Lookup retrieves the value of the key from the winning layer.
+func (s *LayeredSource) Lookup(key string) (string, bool) {
+	val, _, ok := s.resolve(key)
+
+	return val, ok
+}
+
+// Origin returns the name of the layer the value of the key is resolved from.
+// The boolean result reports whether the key is present in any layer.
+func (s *LayeredSource) Origin(key string) (string, bool) {
+	_, name, ok := s.resolve(key)
+
+	return name, ok
+}
+
+// resolve returns the value of the key and the name of the layer it is resolved from.
+func (s *LayeredSource) resolve(key string) (string, string, bool) {
+	n := len(s.layers)
+
+	for i := range n {
+		layer := s.layers[i]
+		if s.precedence == LastWins {
+			layer = s.layers[n-1-i]
+		}
+
+		if layer.Source == nil {
+			continue
+		}
+
+		val, ok := layer.Source.Lookup(key)
+		if ok && val != "" {
+			return val, layer.Name, true
+		}
+	}
+
+	return "", "", false
+}
@@ -0,0 +1,133 @@
+package getenv_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/getenv"
+	"github.com/obalunenko/getenv/option"
+)
+
+func TestLayeredSource(t *testing.T) {
+	layers := []getenv.Layer{
+		{
+			Name: "defaults",
+			Source: getenv.MapSource{
+				"HOST":  "localhost",
+				"PORT":  "80",
+				"DEBUG": "false",
+			},
+		},
+		{
+			Name: ".env",
+			Source: getenv.MapSource{
+				"PORT":  "8080",
+				"DEBUG": "",
+			},
+		},
+		{
+			Name: "nil layer",
+		},
+		{
+			Name: "environment",
+			Source: getenv.MapSource{
+				"PORT": "9090",
+			},
+		},
+	}
+
+	type expected struct {
+		val    string
+		origin string
+		ok     bool
+	}
+
+	tests := []struct {
+		name       string
+		precedence getenv.Precedence
+		key        string
+		expected   expected
+	}{
+		{
+			name:       "last wins, top layer",
+			precedence: getenv.LastWins,
+			key:        "PORT",
+			expected: expected{
+				val:    "9090",
+				origin: "environment",
+				ok:     true,
+			},
+		},
+		{
+			name:       "last wins, bottom layer",
+			precedence: getenv.LastWins,
+			key:        "HOST",
+			expected: expected{
+				val:    "localhost",
+				origin: "defaults",
+				ok:     true,
+			},
+		},
+		{
+			name:       "empty value skipped",
+			precedence: getenv.LastWins,
+			key:        "DEBUG",
+			expected: expected{
+				val:    "false",
+				origin: "defaults",
+				ok:     true,
+			},
+		},
+		{
+			name:       "first wins",
+			precedence: getenv.FirstWins,
+			key:        "PORT",
+			expected: expected{
+				val:    "80",
+				origin: "defaults",
+				ok:     true,
+			},
+		},
+		{
+			name:       "missing",
+			precedence: getenv.FirstWins,
+			key:        "MISSING",
+			expected: expected{
+				ok: false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := getenv.NewLayeredSource(tt.precedence, layers...)
+
+			val, ok := src.Lookup(tt.key)
+			assert.Equal(t, tt.expected.ok, ok)
+			assert.Equal(t, tt.expected.val, val)
+
+			origin, ok := src.Origin(tt.key)
+			assert.Equal(t, tt.expected.ok, ok)
+			assert.Equal(t, tt.expected.origin, origin)
+		})
+	}
+}
+
+func TestLayeredSourceEnv(t *testing.T) {
+	t.Setenv(testEnvKey, "3")
+
+	src := getenv.NewLayeredSource(getenv.LastWins,
+		getenv.Layer{Name: "defaults", Source: getenv.MapSource{testEnvKey: "1"}},
+		getenv.Layer{Name: "environment", Source: getenv.OSEnv{}},
+	)
+
+	got, err := getenv.Env[int](testEnvKey, option.WithSource(src))
+	require.NoError(t, err)
+	assert.Equal(t, 3, got)
+
+	origin, ok := src.Origin(testEnvKey)
+	assert.True(t, ok)
+	assert.Equal(t, "environment", origin)
+}
@@ -0,0 +1,38 @@
+package getenv
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/obalunenko/getenv/internal"
+	"github.com/obalunenko/getenv/option"
+)
+
+// EnvMap retrieves the value of the environment variable named by the key and parses it into map[K]V,
+// e.g. "team:core,env:prod" with option.WithSeparator(",") and option.WithKeyValueSeparator(":").
+//
+// Pairs are split with option.WithPairSeparator or, when it is not set, with option.WithSeparator.
+// Both the keys and the values may be of any scalar type supported by EnvCustom, e.g. map[int]time.Duration.
+// Duplicate keys, pairs without the key/value separator and empty keys or values are reported as ErrInvalidValue.
+// Env does not accept maps, EnvCustom and Bind support them as well.
+func EnvMap[K comparable, V any](key string, options ...option.Option) (map[K]V, error) {
+	t := reflect.TypeFor[map[K]V]()
+
+	w, ok := internal.LookupEnvParser(reflect.Zero(t).Interface())
+	if !ok {
+		return nil, fmt.Errorf("failed to parse environment variable[%s]: %w %s", key, errUnsupportedType, t)
+	}
+
+	return parseEnv[map[K]V](w, key, options)
+}
+
+// EnvMapOrDefault retrieves the value of the environment variable named by the key like EnvMap.
+// If the variable is not present or cannot be parsed, the default value will be returned.
+func EnvMapOrDefault[K comparable, V any](key string, defaultVal map[K]V, options ...option.Option) map[K]V {
+	val, err := EnvMap[K, V](key, options...)
+	if err != nil {
+		return defaultVal
+	}
+
+	return val
+}
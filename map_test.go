@@ -0,0 +1,61 @@
+package getenv_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/getenv"
+	"github.com/obalunenko/getenv/option"
+)
+
+func TestEnvMap(t *testing.T) {
+	src := getenv.MapSource{
+		"LABELS":    "team:core,env:prod",
+		"LIMITS":    "1=10;2=20",
+		"DUPLICATE": "a=1;a=2",
+	}
+
+	labels, err := getenv.EnvMap[string, string]("LABELS",
+		option.WithSource(src), option.WithSeparator(","), option.WithKeyValueSeparator(":"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "core", "env": "prod"}, labels)
+
+	limits, err := getenv.EnvMap[int, uint16]("LIMITS",
+		option.WithSource(src), option.WithPairSeparator(";"), option.WithKeyValueSeparator("="))
+	require.NoError(t, err)
+	assert.Equal(t, map[int]uint16{1: 10, 2: 20}, limits)
+
+	_, err = getenv.EnvMap[string, int]("DUPLICATE",
+		option.WithSource(src), option.WithPairSeparator(";"), option.WithKeyValueSeparator("="))
+	errorEqual(getenv.ErrInvalidValue)(t, err)
+	assert.ErrorContains(t, err, "duplicate key")
+
+	_, err = getenv.EnvMap[string, int]("MISSING", option.WithSource(src))
+	errorEqual(getenv.ErrNotSet)(t, err)
+
+	_, err = getenv.EnvMap[string, []int]("LIMITS", option.WithSource(src))
+	assert.ErrorContains(t, err, "unsupported type map[string][]int")
+
+	def := map[string]time.Duration{"a": time.Second}
+	assert.Equal(t, def, getenv.EnvMapOrDefault("MISSING", def, option.WithSource(src)))
+}
+
+func TestBindMap(t *testing.T) {
+	type config struct {
+		Labels map[string]string `env:"GH_GETENV_BIND_LABELS" sep:"," kvsep:":"`
+		Limits map[string]int    `env:"GH_GETENV_BIND_LIMITS" sep:";" kvsep:"=" default:"a=1;b=2"`
+	}
+
+	t.Setenv("GH_GETENV_BIND_LABELS", "team:core,env:prod")
+
+	var cfg config
+
+	require.NoError(t, getenv.Bind(&cfg))
+	assert.Equal(t, config{
+		Labels: map[string]string{"team": "core", "env": "prod"},
+		Limits: map[string]int{"a": 1, "b": 2},
+	}, cfg)
+}
@@ -0,0 +1,28 @@
+package getenv
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/obalunenko/getenv/internal"
+	"github.com/obalunenko/getenv/option"
+)
+
+// EnvSlices retrieves the value of the environment variable named by the key and parses it into [][]T,
+// e.g. "h1,h2;h3,h4" with option.WithOuterSeparator(";") and option.WithInnerSeparator(",").
+//
+// Rows are split with option.WithOuterSeparator, the elements of each row are split with
+// option.WithInnerSeparator or, when it is not set, with option.WithSeparator.
+// T may be any scalar type supported by EnvCustom. Invalid and empty elements are reported as ErrInvalidValue
+// with the row and the column of the element, e.g. "element [1][0]".
+// Two-level slices are supported by EnvCustom and Bind as well.
+func EnvSlices[T any](key string, options ...option.Option) ([][]T, error) {
+	t := reflect.TypeFor[[][]T]()
+
+	w, ok := internal.LookupEnvParser(reflect.Zero(t).Interface())
+	if !ok {
+		return nil, fmt.Errorf("failed to parse environment variable[%s]: %w %s", key, errUnsupportedType, t)
+	}
+
+	return parseEnv[[][]T](w, key, options)
+}
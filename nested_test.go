@@ -0,0 +1,60 @@
+package getenv_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/getenv"
+	"github.com/obalunenko/getenv/option"
+)
+
+func TestEnvSlices(t *testing.T) {
+	src := getenv.MapSource{
+		"SHARDS":  "h1,h2;h3,h4",
+		"PORTS":   "80|443/8080",
+		"INVALID": "1,2;3,x",
+	}
+
+	shards, err := getenv.EnvSlices[string]("SHARDS",
+		option.WithSource(src), option.WithOuterSeparator(";"), option.WithSeparator(","))
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"h1", "h2"}, {"h3", "h4"}}, shards)
+
+	ports, err := getenv.EnvCustom[[][]uint16]("PORTS",
+		option.WithSource(src), option.WithOuterSeparator("/"), option.WithInnerSeparator("|"))
+	require.NoError(t, err)
+	assert.Equal(t, [][]uint16{{80, 443}, {8080}}, ports)
+
+	_, err = getenv.EnvSlices[int]("INVALID",
+		option.WithSource(src), option.WithOuterSeparator(";"), option.WithInnerSeparator(","))
+	errorEqual(getenv.ErrInvalidValue)(t, err)
+	assert.ErrorContains(t, err, `element [1][1] "x"`)
+
+	var pe *getenv.ParseError
+
+	require.ErrorAs(t, err, &pe)
+	assert.Equal(t, 1, pe.Index)
+	assert.Equal(t, 1, pe.Column)
+	assert.Equal(t, "x", pe.Raw)
+
+	_, err = getenv.EnvSlices[int]("MISSING", option.WithSource(src))
+	errorEqual(getenv.ErrNotSet)(t, err)
+
+	_, err = getenv.EnvSlices[chan int]("SHARDS", option.WithSource(src))
+	assert.ErrorContains(t, err, "unsupported type [][]chan int")
+}
+
+func TestBindSlices(t *testing.T) {
+	type config struct {
+		Shards [][]string `env:"GH_GETENV_BIND_SHARDS" sep:"," outersep:";"`
+	}
+
+	t.Setenv("GH_GETENV_BIND_SHARDS", "h1,h2;h3")
+
+	var cfg config
+
+	require.NoError(t, getenv.Bind(&cfg))
+	assert.Equal(t, [][]string{{"h1", "h2"}, {"h3"}}, cfg.Shards)
+}
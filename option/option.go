@@ -2,6 +2,9 @@
 package option
 
 import (
+	"regexp"
+	"time"
+
 	"github.com/obalunenko/getenv/internal"
 )
 
@@ -26,9 +29,340 @@ func WithSeparator(separator string) Option {
 
 func (w withTimeLayout) Apply(p *internal.Parameters) {
 	p.Layout = string(w)
+	p.Layouts = nil
 }
 
 // WithTimeLayout adds time.Time layout option.
 func WithTimeLayout(layout string) Option {
 	return withTimeLayout(layout)
 }
+
+type withTimeLayouts []string
+
+func (w withTimeLayouts) Apply(p *internal.Parameters) {
+	p.Layout = ""
+	p.Layouts = w
+}
+
+// WithTimeLayouts adds time.Time layouts option, the layouts are tried in order and the first match is used.
+// When none matches, all the layouts are reported in the error. It replaces WithTimeLayout and vice versa.
+func WithTimeLayouts(layouts ...string) Option {
+	return withTimeLayouts(layouts)
+}
+
+// WithAutoTimeLayout adds the automatic time.Time layout detection option: the values are parsed
+// with the first matching layout of time.RFC3339 (fractional seconds included), "2006-01-02T15:04:05",
+// time.DateTime, time.DateOnly, time.RFC1123Z, time.RFC1123, time.RFC850, time.RFC822Z, time.RFC822,
+// time.RubyDate, time.UnixDate and time.ANSIC. It replaces WithTimeLayout and vice versa.
+func WithAutoTimeLayout() Option {
+	return withTimeLayouts(internal.AutoLayouts)
+}
+
+type withPrefix string
+
+func (w withPrefix) Apply(p *internal.Parameters) {
+	p.Prefix = string(w)
+}
+
+// WithPrefix adds key prefix option for struct binding.
+func WithPrefix(prefix string) Option {
+	return withPrefix(prefix)
+}
+
+type withPrefixDelimiter string
+
+func (w withPrefixDelimiter) Apply(p *internal.Parameters) {
+	p.PrefixDelimiter = string(w)
+}
+
+// WithPrefixDelimiter adds key prefix delimiter option for struct binding.
+// The default delimiter is "_".
+func WithPrefixDelimiter(delimiter string) Option {
+	return withPrefixDelimiter(delimiter)
+}
+
+type withRequireAll bool
+
+func (w withRequireAll) Apply(p *internal.Parameters) {
+	p.RequireAll = bool(w)
+}
+
+// WithRequireAll makes every field required for struct binding unless it is tagged as optional.
+func WithRequireAll() Option {
+	return withRequireAll(true)
+}
+
+type withSource struct {
+	src internal.Source
+}
+
+func (w withSource) Apply(p *internal.Parameters) {
+	p.Source = w.src
+}
+
+// WithSource adds source of raw values option, see getenv.Source.
+// The process environment is used when the option is not set.
+func WithSource(src internal.Source) Option {
+	return withSource{
+		src: src,
+	}
+}
+
+type withFileFallback bool
+
+func (w withFileFallback) Apply(p *internal.Parameters) {
+	p.FileFallback = bool(w)
+}
+
+// WithFileFallback adds KEY_FILE convention option.
+// When the variable KEY is not set, the value is read from the file named by KEY_FILE
+// and trimmed of surrounding whitespace. Setting both KEY and KEY_FILE is an error.
+func WithFileFallback() Option {
+	return withFileFallback(true)
+}
+
+type withExpand bool
+
+func (w withExpand) Apply(p *internal.Parameters) {
+	p.Expand = bool(w)
+}
+
+// WithExpand adds variable references expansion option.
+// References are expanded in the raw value before it is parsed:
+// ${VAR} is replaced with the value of VAR, ${VAR:-default} falls back to default when VAR is not set,
+// ${VAR:?message} fails with the message when VAR is not set and $$ is replaced with a single $.
+// Referenced variables are read from the same source and expanded recursively, cycles are reported as errors.
+func WithExpand() Option {
+	return withExpand(true)
+}
+
+type withPairSeparator string
+
+func (w withPairSeparator) Apply(p *internal.Parameters) {
+	p.PairSeparator = string(w)
+}
+
+// WithPairSeparator adds map pair separator option, e.g. "," for "a=1,b=2".
+// The slice separator set with WithSeparator is used when the option is not set.
+func WithPairSeparator(separator string) Option {
+	return withPairSeparator(separator)
+}
+
+type withKeyValueSeparator string
+
+func (w withKeyValueSeparator) Apply(p *internal.Parameters) {
+	p.KeyValueSeparator = string(w)
+}
+
+// WithKeyValueSeparator adds map key/value separator option, e.g. "=" for "a=1,b=2".
+func WithKeyValueSeparator(separator string) Option {
+	return withKeyValueSeparator(separator)
+}
+
+type withOuterSeparator string
+
+func (w withOuterSeparator) Apply(p *internal.Parameters) {
+	p.OuterSeparator = string(w)
+}
+
+// WithOuterSeparator adds two-level slice row separator option, e.g. ";" for "h1,h2;h3,h4".
+func WithOuterSeparator(separator string) Option {
+	return withOuterSeparator(separator)
+}
+
+type withInnerSeparator string
+
+func (w withInnerSeparator) Apply(p *internal.Parameters) {
+	p.InnerSeparator = string(w)
+}
+
+// WithInnerSeparator adds two-level slice element separator option, e.g. "," for "h1,h2;h3,h4".
+// The slice separator set with WithSeparator is used when the option is not set.
+func WithInnerSeparator(separator string) Option {
+	return withInnerSeparator(separator)
+}
+
+type withSensitive bool
+
+func (w withSensitive) Apply(p *internal.Parameters) {
+	p.Sensitive = bool(w)
+}
+
+// WithSensitive marks the value as sensitive, e.g. a password or a token:
+// errors report the key and the type but not the raw value.
+func WithSensitive() Option {
+	return withSensitive(true)
+}
+
+type withBase struct {
+	base int
+	auto bool
+}
+
+func (w withBase) Apply(p *internal.Parameters) {
+	p.Base = w.base
+	p.AutoBase = w.auto
+}
+
+// WithBase adds integer base option, from 2 to 36, for integers and slices of them, e.g. 16 for "ff00".
+// Base 0 detects the base from the prefix like strconv.ParseInt does: 0x for 16, 0o or 0 for 8, 0b for 2
+// and 10 otherwise, underscores between the digits are allowed, e.g. "0644", "0xFF00" or "1_000_000".
+// Integers are decimal when the option is not set. Other bases are reported as getenv.ErrInvalidBase.
+func WithBase(base int) Option {
+	return withBase{
+		base: base,
+		auto: base == 0,
+	}
+}
+
+type withMin struct {
+	v any
+}
+
+func (w withMin) Apply(p *internal.Parameters) {
+	p.Min = w.v
+}
+
+// WithMin adds the minimum option for numbers, durations, byte sizes and slices of them.
+// Smaller values are reported as getenv.ErrOutOfRange. The minimum is compared by value, not by type,
+// so WithMin(1) works for uint16 as well.
+func WithMin[T internal.Bound](minimum T) Option {
+	return withMin{
+		v: minimum,
+	}
+}
+
+type withMax struct {
+	v any
+}
+
+func (w withMax) Apply(p *internal.Parameters) {
+	p.Max = w.v
+}
+
+// WithMax adds the maximum option for numbers, durations, byte sizes and slices of them.
+// Greater values are reported as getenv.ErrOutOfRange. The maximum is compared by value, not by type.
+func WithMax[T internal.Bound](maximum T) Option {
+	return withMax{
+		v: maximum,
+	}
+}
+
+type withRange struct {
+	minimum any
+	maximum any
+}
+
+func (w withRange) Apply(p *internal.Parameters) {
+	p.Min = w.minimum
+	p.Max = w.maximum
+}
+
+// WithRange adds both the minimum and the maximum options, see WithMin and WithMax.
+func WithRange[T internal.Bound](minimum, maximum T) Option {
+	return withRange{
+		minimum: minimum,
+		maximum: maximum,
+	}
+}
+
+type withOneOf []any
+
+func (w withOneOf) Apply(p *internal.Parameters) {
+	p.OneOf = w
+}
+
+// WithOneOf adds the allowed values option for strings, numbers, durations, byte sizes and slices of them.
+// Other values are reported as getenv.ErrNotAllowed with the list of the allowed values
+// and, for strings, the closest allowed value as a suggestion. Numbers are compared by value, not by type.
+func WithOneOf[T internal.Allowed](values ...T) Option {
+	w := make(withOneOf, 0, len(values))
+
+	for _, v := range values {
+		w = append(w, v)
+	}
+
+	return w
+}
+
+type withIgnoreCase bool
+
+func (w withIgnoreCase) Apply(p *internal.Parameters) {
+	p.IgnoreCase = bool(w)
+}
+
+// WithIgnoreCase makes the strings allowed with WithOneOf case-insensitive.
+// The value is returned as it is listed in WithOneOf, e.g. "json" for "JSON".
+func WithIgnoreCase() Option {
+	return withIgnoreCase(true)
+}
+
+type withPattern struct {
+	re *regexp.Regexp
+}
+
+func (w withPattern) Apply(p *internal.Parameters) {
+	p.Pattern = w.re
+}
+
+// WithPattern adds the regular expression option for strings and slices of them, each element is checked.
+// Values that do not match are reported as getenv.ErrInvalidValue with the pattern in the message.
+// The pattern matches any part of the value unless it is anchored, e.g. `^[a-z0-9-]+$`.
+func WithPattern(re *regexp.Regexp) Option {
+	return withPattern{
+		re: re,
+	}
+}
+
+type withTrimSpace bool
+
+func (w withTrimSpace) Apply(p *internal.Parameters) {
+	p.TrimSpace = bool(w)
+}
+
+// WithTrimSpace adds the option to trim leading and trailing white space of each slice element,
+// two-level slice element and map pair, key and value, e.g. "a, b" is read as ["a" "b"].
+func WithTrimSpace() Option {
+	return withTrimSpace(true)
+}
+
+// EmptyPolicy is the policy for the empty elements of slices and the empty map pairs, see WithEmptyElements.
+type EmptyPolicy = internal.EmptyPolicy
+
+const (
+	// EmptyKeep passes empty elements to the element parser, it is the default.
+	// Empty strings are kept, empty elements of other types and empty map pairs are reported as getenv.ErrEmptyElement.
+	EmptyKeep = internal.EmptyKeep
+	// EmptyDrop drops empty elements, e.g. "a,,b" is read as ["a" "b"].
+	EmptyDrop = internal.EmptyDrop
+	// EmptyReject reports empty elements of any type, strings included, as getenv.ErrEmptyElement.
+	EmptyReject = internal.EmptyReject
+)
+
+type withEmptyElements internal.EmptyPolicy
+
+func (w withEmptyElements) Apply(p *internal.Parameters) {
+	p.EmptyElements = internal.EmptyPolicy(w)
+}
+
+// WithEmptyElements adds the policy for the empty elements of slices and the empty map pairs.
+// Elements are checked after WithTrimSpace, so white space only elements are empty when it is set.
+func WithEmptyElements(policy EmptyPolicy) Option {
+	return withEmptyElements(policy)
+}
+
+type withLocation struct {
+	loc *time.Location
+}
+
+func (w withLocation) Apply(p *internal.Parameters) {
+	p.Location = w.loc
+}
+
+// WithLocation adds the location option for time.Time and slices of them: values without the time zone
+// are parsed as the wall time in loc instead of UTC, values with the time zone keep it, see time.ParseInLocation.
+func WithLocation(loc *time.Location) Option {
+	return withLocation{
+		loc: loc,
+	}
+}
@@ -1,6 +1,7 @@
 package option
 
 import (
+	"regexp"
 	"testing"
 	"time"
 
@@ -36,3 +37,199 @@ func TestOptions(t *testing.T) {
 
 	assert.Equal(t, expected, p)
 }
+
+func TestTimeLayoutOptions(t *testing.T) {
+	var p internal.Parameters
+
+	WithTimeLayout(time.RFC822).Apply(&p)
+	WithTimeLayouts(time.DateOnly, time.DateTime).Apply(&p)
+
+	assert.Equal(t, internal.Parameters{Layouts: []string{time.DateOnly, time.DateTime}}, p)
+
+	WithAutoTimeLayout().Apply(&p)
+
+	assert.Equal(t, internal.Parameters{Layouts: internal.AutoLayouts}, p)
+
+	WithTimeLayout(time.RFC822).Apply(&p)
+
+	assert.Equal(t, internal.Parameters{Layout: time.RFC822}, p)
+}
+
+func TestPrefixOptions(t *testing.T) {
+	var p internal.Parameters
+
+	WithPrefix("APP").Apply(&p)
+	WithPrefixDelimiter("__").Apply(&p)
+
+	expected := internal.Parameters{
+		Prefix:          "APP",
+		PrefixDelimiter: "__",
+	}
+
+	assert.Equal(t, expected, p)
+}
+
+func TestWithRequireAll(t *testing.T) {
+	var p internal.Parameters
+
+	WithRequireAll().Apply(&p)
+
+	assert.Equal(t, internal.Parameters{RequireAll: true}, p)
+}
+
+type testSource map[string]string
+
+func (s testSource) Lookup(key string) (string, bool) {
+	v, ok := s[key]
+
+	return v, ok
+}
+
+func TestWithSource(t *testing.T) {
+	var p internal.Parameters
+
+	src := testSource{
+		"KEY": "value",
+	}
+
+	WithSource(src).Apply(&p)
+
+	assert.Equal(t, internal.Parameters{Source: src}, p)
+}
+
+func TestWithFileFallback(t *testing.T) {
+	var p internal.Parameters
+
+	WithFileFallback().Apply(&p)
+
+	assert.Equal(t, internal.Parameters{FileFallback: true}, p)
+}
+
+func TestWithExpand(t *testing.T) {
+	var p internal.Parameters
+
+	WithExpand().Apply(&p)
+
+	assert.Equal(t, internal.Parameters{Expand: true}, p)
+}
+
+func TestMapSeparatorOptions(t *testing.T) {
+	var p internal.Parameters
+
+	WithPairSeparator(";").Apply(&p)
+	WithKeyValueSeparator(":").Apply(&p)
+
+	expected := internal.Parameters{
+		PairSeparator:     ";",
+		KeyValueSeparator: ":",
+	}
+
+	assert.Equal(t, expected, p)
+}
+
+func TestNestedSliceSeparatorOptions(t *testing.T) {
+	var p internal.Parameters
+
+	WithOuterSeparator(";").Apply(&p)
+	WithInnerSeparator(",").Apply(&p)
+
+	expected := internal.Parameters{
+		OuterSeparator: ";",
+		InnerSeparator: ",",
+	}
+
+	assert.Equal(t, expected, p)
+}
+
+func TestWithSensitive(t *testing.T) {
+	var p internal.Parameters
+
+	WithSensitive().Apply(&p)
+
+	assert.Equal(t, internal.Parameters{Sensitive: true}, p)
+}
+
+func TestWithBase(t *testing.T) {
+	var p internal.Parameters
+
+	WithBase(16).Apply(&p)
+
+	assert.Equal(t, internal.Parameters{Base: 16}, p)
+
+	WithBase(0).Apply(&p)
+
+	assert.Equal(t, internal.Parameters{AutoBase: true}, p)
+
+	WithBase(-1).Apply(&p)
+
+	assert.Equal(t, internal.Parameters{Base: -1}, p)
+}
+
+func TestBoundOptions(t *testing.T) {
+	var p internal.Parameters
+
+	WithMin(1).Apply(&p)
+	WithMax(time.Minute).Apply(&p)
+
+	assert.Equal(t, internal.Parameters{Min: 1, Max: time.Minute}, p)
+
+	WithRange(uint16(1), uint16(1024)).Apply(&p)
+
+	assert.Equal(t, internal.Parameters{Min: uint16(1), Max: uint16(1024)}, p)
+}
+
+func TestOneOfOptions(t *testing.T) {
+	var p internal.Parameters
+
+	WithOneOf("json", "text").Apply(&p)
+	WithIgnoreCase().Apply(&p)
+
+	expected := internal.Parameters{
+		OneOf:      []any{"json", "text"},
+		IgnoreCase: true,
+	}
+
+	assert.Equal(t, expected, p)
+
+	WithOneOf(80, 443).Apply(&p)
+
+	assert.Equal(t, []any{80, 443}, p.OneOf)
+}
+
+func TestWithPattern(t *testing.T) {
+	var p internal.Parameters
+
+	re := regexp.MustCompile(`^[a-z]+$`)
+
+	WithPattern(re).Apply(&p)
+
+	assert.Equal(t, internal.Parameters{Pattern: re}, p)
+}
+
+func TestWithTrimSpace(t *testing.T) {
+	var p internal.Parameters
+
+	WithTrimSpace().Apply(&p)
+
+	assert.Equal(t, internal.Parameters{TrimSpace: true}, p)
+}
+
+func TestWithEmptyElements(t *testing.T) {
+	for _, policy := range []EmptyPolicy{EmptyKeep, EmptyDrop, EmptyReject} {
+		var p internal.Parameters
+
+		WithEmptyElements(policy).Apply(&p)
+
+		assert.Equal(t, internal.Parameters{EmptyElements: policy}, p)
+	}
+}
+
+func TestWithLocation(t *testing.T) {
+	var p internal.Parameters
+
+	loc := time.FixedZone("CET", 3600)
+
+	WithLocation(loc).Apply(&p)
+
+	assert.Equal(t, internal.Parameters{Location: loc}, p)
+}
@@ -0,0 +1,102 @@
+package getenv_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/getenv"
+	"github.com/obalunenko/getenv/option"
+)
+
+func TestEnvPointer(t *testing.T) {
+	src := getenv.MapSource{
+		"PORT":    "8080",
+		"TIMEOUT": "30s",
+		"URL":     "https://example.com",
+		"PEERS":   "a,b",
+		"EMPTY":   "",
+		"INVALID": "80s",
+	}
+
+	port, err := getenv.Env[*int]("PORT", option.WithSource(src))
+	require.NoError(t, err)
+	require.NotNil(t, port)
+	assert.Equal(t, 8080, *port)
+
+	timeout, err := getenv.Env[*time.Duration]("TIMEOUT", option.WithSource(src))
+	require.NoError(t, err)
+	require.NotNil(t, timeout)
+	assert.Equal(t, 30*time.Second, *timeout)
+
+	u, err := getenv.Env[*url.URL]("URL", option.WithSource(src))
+	require.NoError(t, err)
+	require.NotNil(t, u)
+	assert.Equal(t, getURL(t, "https://example.com"), *u)
+
+	peers, err := getenv.Env[*[]string]("PEERS", option.WithSource(src), option.WithSeparator(","))
+	require.NoError(t, err)
+	require.NotNil(t, peers)
+	assert.Equal(t, []string{"a", "b"}, *peers)
+
+	ports, err := getenv.Env[*[]int]("MISSING", option.WithSource(src), option.WithSeparator(","))
+	require.NoError(t, err)
+	assert.Nil(t, ports)
+
+	port, err = getenv.Env[*int]("MISSING", option.WithSource(src))
+	require.NoError(t, err)
+	assert.Nil(t, port)
+
+	port, err = getenv.Env[*int]("EMPTY", option.WithSource(src))
+	require.NoError(t, err)
+	assert.Nil(t, port)
+
+	_, err = getenv.Env[*int]("INVALID", option.WithSource(src))
+	errorEqual(getenv.ErrInvalidValue)(t, err)
+}
+
+func TestEnvOrDefaultPointer(t *testing.T) {
+	src := getenv.MapSource{
+		"PORT": "8080",
+	}
+
+	def := 80
+
+	got := getenv.EnvOrDefault("PORT", &def, option.WithSource(src))
+	require.NotNil(t, got)
+	assert.Equal(t, 8080, *got)
+
+	assert.Equal(t, &def, getenv.EnvOrDefault("MISSING", &def, option.WithSource(src)))
+}
+
+func TestBindPointer(t *testing.T) {
+	type config struct {
+		Port    *int           `env:"GH_GETENV_BIND_PTR_PORT"`
+		Timeout *time.Duration `env:"GH_GETENV_BIND_PTR_TIMEOUT" default:"5s"`
+		Token   *string        `env:"GH_GETENV_BIND_PTR_TOKEN,required"`
+		Name    *string        `env:"GH_GETENV_BIND_PTR_NAME"`
+	}
+
+	t.Setenv("GH_GETENV_BIND_PTR_PORT", "8080")
+
+	var cfg config
+
+	err := getenv.Bind(&cfg)
+	errorEqual(getenv.ErrNotSet)(t, err)
+
+	t.Setenv("GH_GETENV_BIND_PTR_TOKEN", "secret")
+
+	cfg = config{}
+
+	require.NoError(t, getenv.Bind(&cfg))
+	require.NotNil(t, cfg.Port)
+	assert.Equal(t, 8080, *cfg.Port)
+	require.NotNil(t, cfg.Timeout)
+	assert.Equal(t, 5*time.Second, *cfg.Timeout)
+	require.NotNil(t, cfg.Token)
+	assert.Equal(t, "secret", *cfg.Token)
+	assert.Nil(t, cfg.Name)
+}
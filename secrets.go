@@ -0,0 +1,59 @@
+package getenv
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SecretsDir is a Source that reads values from files in a directory, one file per key,
+// the way Docker and Kubernetes mount secrets.
+// Missing and unreadable files are reported as not set.
+//
+// Example:
+//
+//	src := getenv.SecretsDir{
+//		Dir:         "/run/secrets",
+//		KeyFunc:     strings.ToLower,
+//		TrimNewline: true,
+//	}
+//
+//	// reads /run/secrets/db_password
+//	password, err := getenv.Env[string]("DB_PASSWORD", option.WithSource(src))
+type SecretsDir struct {
+	// Dir is the directory with the secret files, e.g. /run/secrets.
+	Dir string
+	// KeyFunc maps the key to the file name, e.g. strings.ToLower. The key is used as is when nil.
+	KeyFunc func(key string) string
+	// TrimNewline removes trailing line breaks from the file content.
+	TrimNewline bool
+}
+
+// Lookup retrieves the content of the file for the key.
+func (s SecretsDir) Lookup(key string) (string, bool) {
+	name := key
+	if s.KeyFunc != nil {
+		name = s.KeyFunc(key)
+	}
+
+	if !isPlainFileName(name) {
+		return "", false
+	}
+
+	content, err := os.ReadFile(filepath.Join(s.Dir, name))
+	if err != nil {
+		return "", false
+	}
+
+	val := string(content)
+	if s.TrimNewline {
+		val = strings.TrimRight(val, "\r\n")
+	}
+
+	return val, true
+}
+
+// isPlainFileName reports whether the name refers to a file directly in the directory.
+func isPlainFileName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
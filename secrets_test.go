@@ -0,0 +1,129 @@
+package getenv_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/getenv"
+	"github.com/obalunenko/getenv/option"
+)
+
+func TestSecretsDir(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"db_password": "s3cr3t\n",
+		"DB_PORT":     "5432\r\n",
+		"replicas":    "a,b\n",
+	}
+
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "subdir"), 0o700))
+
+	type expected struct {
+		val string
+		ok  bool
+	}
+
+	tests := []struct {
+		name     string
+		src      getenv.SecretsDir
+		key      string
+		expected expected
+	}{
+		{
+			name: "key transformed and trimmed",
+			src: getenv.SecretsDir{
+				Dir:         dir,
+				KeyFunc:     strings.ToLower,
+				TrimNewline: true,
+			},
+			key: "DB_PASSWORD",
+			expected: expected{
+				val: "s3cr3t",
+				ok:  true,
+			},
+		},
+		{
+			name: "key as is, not trimmed",
+			src: getenv.SecretsDir{
+				Dir: dir,
+			},
+			key: "DB_PORT",
+			expected: expected{
+				val: "5432\r\n",
+				ok:  true,
+			},
+		},
+		{
+			name: "missing file",
+			src: getenv.SecretsDir{
+				Dir: dir,
+			},
+			key: "DB_PASSWORD",
+			expected: expected{
+				ok: false,
+			},
+		},
+		{
+			name: "directory",
+			src: getenv.SecretsDir{
+				Dir: dir,
+			},
+			key: "subdir",
+			expected: expected{
+				ok: false,
+			},
+		},
+		{
+			name: "path traversal",
+			src: getenv.SecretsDir{
+				Dir: filepath.Join(dir, "subdir"),
+			},
+			key: "../replicas",
+			expected: expected{
+				ok: false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			val, ok := tt.src.Lookup(tt.key)
+			assert.Equal(t, tt.expected.ok, ok)
+			assert.Equal(t, tt.expected.val, val)
+		})
+	}
+}
+
+func TestSecretsDirEnv(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "db_port"), []byte("5432\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "replicas"), []byte("a,b\n"), 0o600))
+
+	src := getenv.SecretsDir{
+		Dir:         dir,
+		KeyFunc:     strings.ToLower,
+		TrimNewline: true,
+	}
+
+	port, err := getenv.Env[uint16]("DB_PORT", option.WithSource(src))
+	require.NoError(t, err)
+	assert.Equal(t, uint16(5432), port)
+
+	replicas, err := getenv.Env[[]string]("REPLICAS", option.WithSource(src), option.WithSeparator(","))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, replicas)
+
+	_, err = getenv.Env[string]("MISSING", option.WithSource(src))
+	errorEqual(getenv.ErrNotSet)(t, err)
+}
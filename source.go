@@ -0,0 +1,33 @@
+package getenv
+
+import (
+	"os"
+)
+
+// Source is a contract for looking up raw values of environment variables.
+// Pass it to Env, EnvOrDefault or Bind with option.WithSource to read values
+// from somewhere other than the process environment.
+type Source interface {
+	// Lookup retrieves the value of the variable named by the key.
+	// The boolean result reports whether the variable is present.
+	Lookup(key string) (string, bool)
+}
+
+// OSEnv is a Source backed by the process environment.
+// It is used when no source is set.
+type OSEnv struct{}
+
+// Lookup retrieves the value of the environment variable named by the key.
+func (OSEnv) Lookup(key string) (string, bool) {
+	return os.LookupEnv(key)
+}
+
+// MapSource is a Source backed by a map of values.
+type MapSource map[string]string
+
+// Lookup retrieves the value named by the key from the map.
+func (m MapSource) Lookup(key string) (string, bool) {
+	v, ok := m[key]
+
+	return v, ok
+}
@@ -0,0 +1,70 @@
+package getenv_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/getenv"
+	"github.com/obalunenko/getenv/option"
+)
+
+func TestMapSource(t *testing.T) {
+	t.Setenv(testEnvKey, "99")
+
+	src := getenv.MapSource{
+		testEnvKey:              "42",
+		"GH_GETENV_SLICE":       "1s,2m",
+		"GH_GETENV_EMPTY":       "",
+		"GH_GETENV_INVALID_INT": "4s2",
+	}
+
+	got, err := getenv.Env[int](testEnvKey, option.WithSource(src))
+	require.NoError(t, err)
+	assert.Equal(t, 42, got)
+
+	durations, err := getenv.Env[[]time.Duration]("GH_GETENV_SLICE",
+		option.WithSource(src),
+		option.WithSeparator(","),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Minute}, durations)
+
+	_, err = getenv.Env[string]("GH_GETENV_EMPTY", option.WithSource(src))
+	errorEqual(getenv.ErrNotSet)(t, err)
+
+	_, err = getenv.Env[int]("GH_GETENV_INVALID_INT", option.WithSource(src))
+	errorEqual(getenv.ErrInvalidValue)(t, err)
+
+	assert.Equal(t, 7, getenv.EnvOrDefault("GH_GETENV_NOT_IN_SOURCE", 7, option.WithSource(src)))
+}
+
+func TestOSEnv(t *testing.T) {
+	t.Setenv(testEnvKey, "value")
+
+	got, err := getenv.Env[string](testEnvKey, option.WithSource(getenv.OSEnv{}))
+	require.NoError(t, err)
+	assert.Equal(t, "value", got)
+
+	val, ok := getenv.OSEnv{}.Lookup("GH_GETENV_NOT_SET")
+	assert.False(t, ok)
+	assert.Empty(t, val)
+}
+
+func TestBindSource(t *testing.T) {
+	type config struct {
+		Host string `env:"HOST"`
+		Port int    `env:"PORT" default:"8080"`
+	}
+
+	src := getenv.MapSource{
+		"APP_HOST": "localhost",
+	}
+
+	var cfg config
+
+	require.NoError(t, getenv.Bind(&cfg, option.WithSource(src), option.WithPrefix("APP")))
+	assert.Equal(t, config{Host: "localhost", Port: 8080}, cfg)
+}
@@ -0,0 +1,28 @@
+package getenv
+
+import (
+	"reflect"
+
+	"github.com/obalunenko/getenv/internal"
+	"github.com/obalunenko/getenv/option"
+)
+
+// EnvText retrieves the value of the environment variable named by the key
+// and unmarshals it into T, whose pointer implements encoding.TextUnmarshaler,
+// e.g. slog.Level or big.Int.
+// Unmarshaling errors are reported as ErrInvalidValue and keep the original error in the chain.
+func EnvText[T any, PT internal.TextUnmarshaler[T]](key string, options ...option.Option) (T, error) {
+	w := internal.NewTextParser(reflect.TypeFor[T]())
+
+	return parseEnv[T](w, key, options)
+}
+
+// EnvTextSlice retrieves the value of the environment variable named by the key,
+// splits it with option.WithSeparator and unmarshals each element into T,
+// whose pointer implements encoding.TextUnmarshaler.
+// Unmarshaling errors are reported as ErrInvalidValue and keep the original error in the chain.
+func EnvTextSlice[T any, PT internal.TextUnmarshaler[T]](key string, options ...option.Option) ([]T, error) {
+	w := internal.NewTextSliceParser(reflect.TypeFor[T]())
+
+	return parseEnv[[]T](w, key, options)
+}
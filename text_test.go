@@ -0,0 +1,77 @@
+package getenv_test
+
+import (
+	"log/slog"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/obalunenko/getenv"
+	"github.com/obalunenko/getenv/option"
+)
+
+func TestEnvText(t *testing.T) {
+	src := getenv.MapSource{
+		"LOG_LEVEL":     "warn",
+		"INVALID_LEVEL": "verbose",
+		"BIG":           "123456789012345678901234567890",
+	}
+
+	level, err := getenv.EnvText[slog.Level]("LOG_LEVEL", option.WithSource(src))
+	require.NoError(t, err)
+	assert.Equal(t, slog.LevelWarn, level)
+
+	_, err = getenv.EnvText[slog.Level]("INVALID_LEVEL", option.WithSource(src))
+	errorEqual(getenv.ErrInvalidValue)(t, err)
+	assert.ErrorContains(t, err, "verbose")
+
+	_, err = getenv.EnvText[slog.Level]("MISSING", option.WithSource(src))
+	errorEqual(getenv.ErrNotSet)(t, err)
+
+	n, err := getenv.EnvText[big.Int]("BIG", option.WithSource(src))
+	require.NoError(t, err)
+
+	expected, ok := new(big.Int).SetString("123456789012345678901234567890", 10)
+	require.True(t, ok)
+	assert.Equal(t, 0, expected.Cmp(&n))
+}
+
+func TestEnvTextSlice(t *testing.T) {
+	src := getenv.MapSource{
+		"LEVELS":         "debug,info,error",
+		"INVALID_LEVELS": "debug,loud",
+	}
+
+	levels, err := getenv.EnvTextSlice[slog.Level]("LEVELS", option.WithSource(src), option.WithSeparator(","))
+	require.NoError(t, err)
+	assert.Equal(t, []slog.Level{slog.LevelDebug, slog.LevelInfo, slog.LevelError}, levels)
+
+	_, err = getenv.EnvTextSlice[slog.Level]("INVALID_LEVELS", option.WithSource(src), option.WithSeparator(","))
+	errorEqual(getenv.ErrInvalidValue)(t, err)
+
+	_, err = getenv.EnvTextSlice[slog.Level]("LEVELS", option.WithSource(src))
+	errorEqual(getenv.ErrMissingSeparator)(t, err)
+}
+
+func TestBindText(t *testing.T) {
+	type config struct {
+		Level  slog.Level   `env:"LOG_LEVEL"`
+		Levels []slog.Level `env:"LOG_LEVELS" sep:","`
+	}
+
+	src := getenv.MapSource{
+		"LOG_LEVEL":  "error",
+		"LOG_LEVELS": "debug,info",
+	}
+
+	var cfg config
+
+	require.NoError(t, getenv.Bind(&cfg, option.WithSource(src)))
+	assert.Equal(t, config{Level: slog.LevelError, Levels: []slog.Level{slog.LevelDebug, slog.LevelInfo}}, cfg)
+
+	level, err := getenv.EnvCustom[slog.Level]("LOG_LEVEL", option.WithSource(src))
+	require.NoError(t, err)
+	assert.Equal(t, slog.LevelError, level)
+}